@@ -1,6 +1,9 @@
 package validation_test
 
 import (
+	"crypto/sha256"
+	"runtime"
+	"strconv"
 	"testing"
 
 	"github.com/jacoelho/validation"
@@ -95,3 +98,134 @@ func BenchmarkValidation(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkParallel(b *testing.B) {
+	type Batch struct {
+		Items []string
+	}
+
+	expensive := func(value string) *validation.Error {
+		sum := sha256.Sum256([]byte(value))
+		for range 100 {
+			sum = sha256.Sum256(sum[:])
+		}
+		if sum[0] == 0 && value == "" {
+			return &validation.Error{Code: "hash"}
+		}
+		return nil
+	}
+
+	validator := validation.Struct(
+		validation.SliceStructField("Items", func(b Batch) []string { return b.Items },
+			validation.Value(validation.NotZero[string](), expensive),
+		),
+	)
+
+	batch := Batch{Items: make([]string, 10000)}
+	for i := range batch.Items {
+		batch.Items[i] = strconv.Itoa(i)
+	}
+
+	b.Run("Sequential", func(b *testing.B) {
+		for b.Loop() {
+			_ = validator.ValidateWithOptions(batch)
+		}
+	})
+
+	b.Run("Parallel", func(b *testing.B) {
+		for b.Loop() {
+			_ = validator.ValidateWithOptions(batch, validation.Parallel(runtime.GOMAXPROCS(0)))
+		}
+	})
+}
+
+// validAllocationCases returns validations of a valid value, which must not allocate.
+// They are checked by TestValidAllocations and measured by BenchmarkValidAllocations.
+func validAllocationCases() []struct {
+	name string
+	call func()
+} {
+	type Line struct {
+		SKU      string
+		Quantity int
+	}
+
+	type Purchase struct {
+		ID       string
+		Customer *Address
+		Shipping Address
+		Lines    []Line
+	}
+
+	address := validation.Struct(
+		validation.Field("Street", func(a Address) string { return a.Street },
+			validation.NotZero[string](),
+		),
+		validation.Field("City", func(a Address) string { return a.City },
+			validation.NotZero[string](),
+			validation.StringsRuneMaxLength[string](50),
+		),
+	)
+	validator := validation.Struct(
+		validation.Field("ID", func(p Purchase) string { return p.ID },
+			validation.NotZero[string](),
+		),
+		validation.PtrStructField("Customer", func(p Purchase) *Address { return p.Customer }, address).OmitEmpty(),
+		validation.StructField("Shipping", func(p Purchase) Address { return p.Shipping }, address).OmitEmpty(),
+		validation.SliceStructField("Lines", func(p Purchase) []Line { return p.Lines },
+			validation.Struct(
+				validation.Field("SKU", func(l Line) string { return l.SKU },
+					validation.NotZero[string](),
+				),
+				validation.Field("Quantity", func(l Line) int { return l.Quantity },
+					validation.NumbersBetween(1, 100),
+				),
+			),
+			validation.SlicesMinLength[Line](1),
+		).MaxErrors(10),
+	)
+
+	shipping := Address{Street: "Main St", City: "Lisbon"}
+	value := Purchase{
+		ID:       "o-1",
+		Customer: &shipping,
+		Shipping: shipping,
+		Lines:    []Line{{SKU: "a", Quantity: 1}, {SKU: "b", Quantity: 2}, {SKU: "c", Quantity: 3}},
+	}
+
+	return []struct {
+		name string
+		call func()
+	}{
+		{name: "Validate", call: func() { _ = validator.Validate(value) }},
+		{name: "ValidateWithOptions", call: func() { _ = validator.ValidateWithOptions(value, validation.FailFast()) }},
+		{name: "IsValid", call: func() { _ = validator.IsValid(value) }},
+	}
+}
+
+// TestValidAllocations checks that validations of valid values do not allocate.
+func TestValidAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not deterministic with the race detector")
+	}
+
+	for _, tt := range validAllocationCases() {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.call()
+			if allocs := testing.AllocsPerRun(100, tt.call); allocs != 0 {
+				t.Errorf("expected no allocations, got %v", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkValidAllocations(b *testing.B) {
+	for _, tt := range validAllocationCases() {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				tt.call()
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package validation
+
+import "cmp"
+
+// TransitionRule is a function that validates the change of a value.
+type TransitionRule[T any] func(old, new T) *Error
+
+// changeFieldValidator is a validator for the change of a field of a struct.
+type changeFieldValidator[T any] interface {
+	ValidateWithPrefix(old, new T, prefix string) Errors
+}
+
+// ChangeValidator is a validator for the change of a struct from an old to a new value.
+type ChangeValidator[T any] struct {
+	fields []changeFieldValidator[T]
+}
+
+// Change creates a new ChangeValidator with the given fields.
+func Change[T any](fields ...changeFieldValidator[T]) *ChangeValidator[T] {
+	return &ChangeValidator[T]{fields: fields}
+}
+
+// Validate validates the change from old to new.
+func (v *ChangeValidator[T]) Validate(old, new T) Errors {
+	return v.ValidateWithPrefix(old, new, "")
+}
+
+// ValidateWithPrefix validates the change from old to new with a prefix.
+func (v *ChangeValidator[T]) ValidateWithPrefix(old, new T, prefix string) Errors {
+	var out Errors
+	for _, field := range v.fields {
+		out = append(out, field.ValidateWithPrefix(old, new, prefix)...)
+	}
+	return out
+}
+
+// ChangeFieldAccessor is a field of a struct validated on change.
+type ChangeFieldAccessor[T, F any] struct {
+	name      string
+	get       func(T) F
+	rules     []TransitionRule[F]
+	inner     changeFieldValidator[F]
+	condition func(old, new T) bool
+}
+
+// ChangeField creates a new ChangeFieldAccessor with the given name, getter and rules.
+func ChangeField[T, F any](name string, getter func(T) F, rules ...TransitionRule[F]) ChangeFieldAccessor[T, F] {
+	return ChangeFieldAccessor[T, F]{name: name, get: getter, rules: rules}
+}
+
+// ChangeStructField creates a new ChangeFieldAccessor with the given name, getter and validator.
+func ChangeStructField[T, F any](name string, getter func(T) F, validator *ChangeValidator[F]) ChangeFieldAccessor[T, F] {
+	return ChangeFieldAccessor[T, F]{name: name, get: getter, inner: validator}
+}
+
+// When returns a copy of the field that is only validated if the condition is true.
+func (fa ChangeFieldAccessor[T, F]) When(condition func(old, new T) bool) ChangeFieldAccessor[T, F] {
+	fa.condition = condition
+	return fa
+}
+
+// ValidateWithPrefix validates the change of the field with a prefix.
+func (fa ChangeFieldAccessor[T, F]) ValidateWithPrefix(old, new T, prefix string) Errors {
+	if fa.condition != nil && !fa.condition(old, new) {
+		return nil
+	}
+
+	var out Errors
+	oldValue, newValue := fa.get(old), fa.get(new)
+	fieldPath := joinField(prefix, fa.name)
+
+	for _, rule := range fa.rules {
+		if err := rule(oldValue, newValue); err != nil {
+			err.Field = joinField(fieldPath, err.Field)
+			out = append(out, err)
+			if err.isFatal() {
+				return out
+			}
+		}
+	}
+
+	if fa.inner != nil {
+		for _, err := range fa.inner.ValidateWithPrefix(oldValue, newValue, "") {
+			err.Field = joinField(fieldPath, err.Field)
+			out = append(out, err)
+		}
+	}
+	return out
+}
+
+// Immutable validates that the value does not change.
+func Immutable[T comparable]() TransitionRule[T] {
+	return func(old, new T) *Error {
+		if old != new {
+			return &Error{Code: "immutable"}
+		}
+		return nil
+	}
+}
+
+// AllowedTransitions validates that the value only changes to one of the values allowed from the old value.
+// Keeping the same value is always allowed. Other changes report an "invalid_transition" error, like StateMachine.
+func AllowedTransitions[T comparable](allowed map[T][]T) TransitionRule[T] {
+	set := make(map[T]map[T]struct{}, len(allowed))
+	for from, tos := range allowed {
+		set[from] = make(map[T]struct{}, len(tos))
+		for _, to := range tos {
+			set[from][to] = struct{}{}
+		}
+	}
+	return func(old, new T) *Error {
+		if old == new {
+			return nil
+		}
+		if _, ok := set[old][new]; !ok {
+			return &Error{
+				Code:   "invalid_transition",
+				Params: map[string]any{"from": old, "to": new},
+			}
+		}
+		return nil
+	}
+}
+
+// MonotonicIncreasing validates that the value does not decrease.
+func MonotonicIncreasing[T cmp.Ordered]() TransitionRule[T] {
+	return func(old, new T) *Error {
+		if new < old {
+			return &Error{
+				Code:   "monotonic",
+				Params: map[string]any{"direction": "increasing", "old": old, "new": new},
+			}
+		}
+		return nil
+	}
+}
+
+// MonotonicDecreasing validates that the value does not increase.
+func MonotonicDecreasing[T cmp.Ordered]() TransitionRule[T] {
+	return func(old, new T) *Error {
+		if new > old {
+			return &Error{
+				Code:   "monotonic",
+				Params: map[string]any{"direction": "decreasing", "old": old, "new": new},
+			}
+		}
+		return nil
+	}
+}
+
+// NewValue applies the rules to the new value.
+func NewValue[T any](rules ...Rule[T]) TransitionRule[T] {
+	return func(_, new T) *Error {
+		return applyRules(new, rules)
+	}
+}
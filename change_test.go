@@ -0,0 +1,139 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func TestChangeValidator(t *testing.T) {
+	type Item struct {
+		Email    string
+		Verified bool
+		Status   string
+		Quantity int
+		Address  Address
+	}
+
+	validator := validation.Change(
+		validation.ChangeField("Email", func(i Item) string { return i.Email },
+			validation.Immutable[string](),
+		).When(func(old, _ Item) bool { return old.Verified }),
+		validation.ChangeField("Status", func(i Item) string { return i.Status },
+			validation.AllowedTransitions(map[string][]string{
+				"draft":     {"published"},
+				"published": {"archived"},
+			}),
+		),
+		validation.ChangeField("Quantity", func(i Item) int { return i.Quantity },
+			validation.MonotonicDecreasing[int](),
+			validation.NewValue(validation.NumbersNonNegative[int]()),
+		),
+		validation.ChangeStructField("Address", func(i Item) Address { return i.Address },
+			validation.Change(
+				validation.ChangeField("Country", func(a Address) string { return a.Country },
+					validation.Immutable[string](),
+				),
+			),
+		),
+	)
+
+	base := Item{Email: "a@b.c", Status: "draft", Quantity: 10, Address: Address{Country: "PT"}}
+
+	codes := func(errs validation.Errors) []string {
+		var out []string
+		for _, err := range errs {
+			out = append(out, err.Code+":"+err.Field)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		old    Item
+		update func(*Item)
+		want   []string
+	}{
+		{
+			name:   "no changes",
+			old:    base,
+			update: func(*Item) {},
+		},
+		{
+			name:   "allowed changes",
+			old:    base,
+			update: func(i *Item) { i.Email = "x@y.z"; i.Status = "published"; i.Quantity = 5 },
+		},
+		{
+			name:   "email immutable after verification",
+			old:    Item{Email: "a@b.c", Verified: true, Status: "draft"},
+			update: func(i *Item) { i.Email = "x@y.z" },
+			want:   []string{"immutable:Email"},
+		},
+		{
+			name:   "invalid status transition",
+			old:    base,
+			update: func(i *Item) { i.Status = "archived" },
+			want:   []string{"invalid_transition:Status"},
+		},
+		{
+			name:   "quantity increase",
+			old:    base,
+			update: func(i *Item) { i.Quantity = 11 },
+			want:   []string{"monotonic:Quantity"},
+		},
+		{
+			name:   "negative quantity",
+			old:    base,
+			update: func(i *Item) { i.Quantity = -1 },
+			want:   []string{"non_negative:Quantity"},
+		},
+		{
+			name:   "nested immutable",
+			old:    base,
+			update: func(i *Item) { i.Address.Country = "ES" },
+			want:   []string{"immutable:Address.Country"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := tt.old
+			tt.update(&updated)
+			got := codes(validator.Validate(tt.old, updated))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAllowedTransitionsParams(t *testing.T) {
+	rule := validation.AllowedTransitions(map[string][]string{"draft": {"published"}})
+
+	err := rule("draft", "archived")
+	if err == nil {
+		t.Fatal("expected error but got nil")
+	}
+	if err.Params["from"] != "draft" || err.Params["to"] != "archived" {
+		t.Errorf("expected from/to params, got %v", err.Params)
+	}
+	if err := rule("unknown", "unknown"); err != nil {
+		t.Errorf("expected unchanged value to pass, got %v", err)
+	}
+}
+
+func TestMonotonicIncreasing(t *testing.T) {
+	rule := validation.MonotonicIncreasing[int]()
+
+	if err := rule(1, 2); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := rule(2, 2); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := rule(2, 1); err == nil || err.Params["direction"] != "increasing" {
+		t.Errorf("expected monotonic error, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"reflect"
+	"time"
+)
+
+// OmitEmpty applies the rules only if the value is not empty.
+// See FieldAccessor.OmitEmpty for the definition of empty.
+func OmitEmpty[T any](rules ...Rule[T]) Rule[T] {
+	return func(value T) *Error {
+		if isEmpty(value) {
+			return nil
+		}
+		return applyRules(value, rules)
+	}
+}
+
+// zeroer is implemented by values with an IsZero method, such as time.Time.
+type zeroer interface {
+	IsZero() bool
+}
+
+var zeroerType = reflect.TypeFor[zeroer]()
+
+// isEmpty reports whether the value is empty.
+// Nil pointers and interfaces are empty, values implementing IsZero use it, strings,
+// slices and maps are empty when their length is zero, and any other value when it is the zero value.
+// The value is inspected in place so that it does not escape, except to call IsZero.
+func isEmpty[T any](value T) bool {
+	rv := reflect.ValueOf(&value).Elem()
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return true
+	}
+	if t, ok := any(&value).(*time.Time); ok {
+		return t.IsZero()
+	}
+	if rv.Type().Implements(zeroerType) {
+		return isZero(value)
+	}
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
+
+// isZero calls the IsZero method of the value, which is boxed to do so.
+func isZero[T any](value T) bool {
+	return any(value).(zeroer).IsZero()
+}
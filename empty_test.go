@@ -0,0 +1,130 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jacoelho/validation"
+)
+
+func TestOmitEmpty(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		rule := validation.OmitEmpty(
+			validation.StringsRuneMinLength[string](2),
+			validation.StringsContains[string]("@"),
+		)
+		if err := rule(""); err != nil {
+			t.Errorf("expected empty string to be skipped, got %v", err)
+		}
+		if err := rule("a"); err == nil || err.Code != "min" {
+			t.Errorf("expected min error, got %v", err)
+		}
+		if err := rule("ab"); err == nil || err.Code != "contains" {
+			t.Errorf("expected contains error, got %v", err)
+		}
+	})
+
+	t.Run("number", func(t *testing.T) {
+		rule := validation.OmitEmpty(validation.NumbersMin(10))
+		if err := rule(0); err != nil {
+			t.Errorf("expected zero to be skipped, got %v", err)
+		}
+		if err := rule(5); err == nil {
+			t.Error("expected error for non-zero value")
+		}
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		rule := validation.OmitEmpty(validation.Required(validation.TimeAfter(date(2024, 1, 1))))
+		if err := rule(nil); err != nil {
+			t.Errorf("expected nil pointer to be skipped, got %v", err)
+		}
+		zero := time.Time{}
+		if err := rule(&zero); err != nil {
+			t.Errorf("expected pointer to zero time to be skipped, got %v", err)
+		}
+		before := date(2023, 1, 1)
+		if err := rule(&before); err == nil {
+			t.Error("expected error for non-zero time")
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		rule := validation.OmitEmpty(validation.TimeAfter(date(2024, 1, 1)))
+		if err := rule(time.Time{}); err != nil {
+			t.Errorf("expected zero time to be skipped, got %v", err)
+		}
+		if err := rule(date(2023, 1, 1)); err == nil {
+			t.Error("expected error for non-zero time")
+		}
+	})
+}
+
+func TestFieldOmitEmpty(t *testing.T) {
+	type Patch struct {
+		Name     string
+		Age      int
+		Birthday time.Time
+		Expires  *time.Time
+		Tags     []string
+		Settings map[string]string
+		Address  Address
+	}
+
+	validator := validation.Struct(
+		validation.Field("Name", func(p Patch) string { return p.Name },
+			validation.StringsRuneMinLength[string](2),
+		).OmitEmpty(),
+		validation.Field("Age", func(p Patch) int { return p.Age },
+			validation.NumbersMin(18),
+		).OmitEmpty(),
+		validation.Field("Birthday", func(p Patch) time.Time { return p.Birthday },
+			validation.TimeBefore(date(2010, 1, 1)),
+		).OmitEmpty(),
+		validation.Field("Expires", func(p Patch) *time.Time { return p.Expires },
+			validation.Required(validation.TimeAfter(date(2024, 1, 1))),
+		).OmitEmpty(),
+		validation.SliceField("Tags", func(p Patch) []string { return p.Tags },
+			validation.SlicesMinLength[string](2),
+		).OmitEmpty(),
+		validation.MapField("Settings", func(p Patch) map[string]string { return p.Settings },
+			validation.MapsMinKeys[string, string](2),
+		).OmitEmpty(),
+		validation.StructField("Address", func(p Patch) Address { return p.Address },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		).OmitEmpty(),
+	)
+
+	t.Run("empty values are skipped", func(t *testing.T) {
+		errs := validator.Validate(Patch{Tags: []string{}, Settings: map[string]string{}})
+		if len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("provided values are validated", func(t *testing.T) {
+		expires := date(2020, 1, 1)
+		errs := validator.Validate(Patch{
+			Name:     "a",
+			Age:      10,
+			Birthday: date(2020, 1, 1),
+			Expires:  &expires,
+			Tags:     []string{"a"},
+			Settings: map[string]string{"a": "b"},
+			Address:  Address{Street: "Main St"},
+		})
+		want := []string{"Name", "Age", "Birthday", "Expires", "Tags", "Settings", "Address.City"}
+		if len(errs) != len(want) {
+			t.Fatalf("expected %d errors, got %v", len(want), errs)
+		}
+		for i, field := range want {
+			if errs[i].Field != field {
+				t.Errorf("error %d: expected field %q, got %q", i, field, errs[i].Field)
+			}
+		}
+	})
+}
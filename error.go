@@ -2,20 +2,156 @@ package validation
 
 import (
 	"fmt"
-	"sort"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 )
 
+// Severity is the severity of a validation error.
+// The zero value is SeverityError.
+type Severity int
+
+const (
+	// SeverityInfo is an informational diagnostic that does not block.
+	SeverityInfo Severity = iota - 2
+	// SeverityWarning is a diagnostic that does not block, such as a deprecated field.
+	SeverityWarning
+	// SeverityError is a validation failure.
+	SeverityError
+	// SeverityFatal is a validation failure that stops the validation of the field.
+	SeverityFatal
+)
+
+// severityNames are the names of the severities, as returned by String.
+var severityNames = map[Severity]string{
+	SeverityInfo:    "info",
+	SeverityWarning: "warning",
+	SeverityError:   "error",
+	SeverityFatal:   "fatal",
+}
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return "severity(" + strconv.Itoa(int(s)) + ")"
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the severity by name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a severity name.
+func (s *Severity) UnmarshalText(text []byte) error {
+	for severity, name := range severityNames {
+		if name == string(text) {
+			*s = severity
+			return nil
+		}
+	}
+	return fmt.Errorf("validation: unknown severity %q", text)
+}
+
+// ErrCode is an error matching validation errors with the same code with errors.Is,
+// e.g. errors.Is(err, validation.ErrCode("min")).
+type ErrCode string
+
+// Error implements the error interface.
+func (c ErrCode) Error() string {
+	return string(c)
+}
+
 // Error represents a single validation error.
+// Fatal and a Severity of SeverityFatal both mark fatal errors:
+// Level returns the effective severity and is the one to check.
+// Errors created by this package set both, and JSON encoding writes both consistently.
+// Cause is the underlying error, if any, such as the error of a failed parse.
+// Sensitive marks errors of sensitive fields, whose params are redacted, see RedactSensitive.
+// Value is the rejected value, recorded with the CaptureValues option.
 type Error struct {
-	Field  string
-	Code   string
-	Params map[string]any
-	Fatal  bool
+	Field     string
+	Code      string
+	Params    map[string]any
+	Fatal     bool
+	Severity  Severity `json:",omitempty"`
+	Cause     error    `json:"-"`
+	Sensitive bool     `json:"-"`
+	Value     any      `json:",omitempty"`
+
+	rejected    any
+	hasRejected bool
+	nested      bool
+}
+
+// nest sets the field of an error reported for a part of the value validated by a rule,
+// such as an element of SlicesForEach or a capture group of StringsRegexpCaptures.
+// The field is joined with the field of an error already nested, otherwise it replaces it,
+// so rules applied to a field report the field path whatever Field they set.
+func (e *Error) nest(field string) {
+	if e.nested {
+		e.Field = joinField(field, e.Field)
+	} else {
+		e.Field = field
+	}
+	e.nested = true
+}
+
+// reject records the value rejected by a rule applied by another rule, such as the element
+// of SlicesForEach, unless a nested rule already recorded it.
+// The value is exposed in Value with the CaptureValues option.
+func (e *Error) reject(value any) {
+	if !e.hasRejected {
+		e.rejected = value
+		e.hasRejected = true
+	}
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// capture sets the value of the error to the rejected value, or to the value given to the rule.
+func (e *Error) capture(value any) {
+	if e.hasRejected {
+		value = e.rejected
+	}
+	e.Value = value
+}
+
+// Is reports whether target is an ErrCode with the code of the error.
+func (e *Error) Is(target error) bool {
+	code, ok := target.(ErrCode)
+	return ok && e.Code == string(code)
+}
+
+// Level returns the severity of the error, SeverityFatal if Fatal is set.
+// It is the source of truth for the severity, as Fatal and Severity may disagree
+// on errors created outside this package.
+func (e *Error) Level() Severity {
+	if e.Fatal {
+		return SeverityFatal
+	}
+	return e.Severity
+}
+
+// isFatal reports whether the error stops the validation.
+func (e *Error) isFatal() bool {
+	return e.Level() >= SeverityFatal
+}
+
+// blocking reports whether the error fails the validation.
+func (e *Error) blocking() bool {
+	return e.Level() >= SeverityError
 }
 
 // Error implements the error interface.
+// Params are redacted by RedactSensitive.
 func (e *Error) Error() string {
+	e = e.Redacted(RedactSensitive)
 	var sb strings.Builder
 	sb.WriteString(e.Code)
 
@@ -27,12 +163,7 @@ func (e *Error) Error() string {
 
 	if len(e.Params) > 0 {
 		sb.WriteString(" {")
-		keys := make([]string, 0, len(e.Params))
-		for k := range e.Params {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-		for i, k := range keys {
+		for i, k := range sortedParams(e.Params) {
 			if i > 0 {
 				sb.WriteString(", ")
 			}
@@ -41,9 +172,19 @@ func (e *Error) Error() string {
 		sb.WriteString("}")
 	}
 
+	if e.Cause != nil {
+		sb.WriteString(": ")
+		sb.WriteString(e.Cause.Error())
+	}
+
 	return sb.String()
 }
 
+// sortedParams returns the keys of the params in order.
+func sortedParams(params map[string]any) []string {
+	return slices.Sorted(maps.Keys(params))
+}
+
 // Errors is a collection of validation errors.
 type Errors []*Error
 
@@ -60,37 +201,73 @@ func SingleErrorSlice(field, code string, params map[string]any, fatal bool) Err
 }
 
 // HasErrors reports whether any errors exist.
+// Warnings and informational diagnostics are ignored.
 func (errs Errors) HasErrors() bool {
-	return len(errs) > 0
+	for _, e := range errs {
+		if e.blocking() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasWarnings reports whether any warnings exist.
+func (errs Errors) HasWarnings() bool {
+	for _, e := range errs {
+		if e.Level() == SeverityWarning {
+			return true
+		}
+	}
+	return false
 }
 
 // HasFatalErrors reports whether any fatal errors exist.
 func (errs Errors) HasFatalErrors() bool {
-	if len(errs) == 0 {
-		return false
-	}
 	for _, e := range errs {
-		if e.Fatal {
+		if e.isFatal() {
 			return true
 		}
 	}
 	return false
 }
 
+// BySeverity returns the errors with any of the given severities.
+func (errs Errors) BySeverity(severities ...Severity) Errors {
+	var out Errors
+	for _, e := range errs {
+		if slices.Contains(severities, e.Level()) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Blocking returns the errors that fail the validation, excluding warnings and informational diagnostics.
+func (errs Errors) Blocking() Errors {
+	var out Errors
+	for _, e := range errs {
+		if e.blocking() {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
 // Format formats the errors using the given function and separator.
+// The function is given the errors with their params redacted by RedactSensitive.
 func (errs Errors) Format(f func(e *Error) string, sep string) string {
 	switch len(errs) {
 	case 0:
 		return ""
 	case 1:
-		return f(errs[0])
+		return f(errs[0].Redacted(RedactSensitive))
 	default:
 		sb := new(strings.Builder)
 		for i, e := range errs {
 			if i > 0 {
 				sb.WriteString(sep)
 			}
-			sb.WriteString(f(e))
+			sb.WriteString(f(e.Redacted(RedactSensitive)))
 		}
 		return sb.String()
 	}
@@ -100,3 +277,21 @@ func (errs Errors) Format(f func(e *Error) string, sep string) string {
 func (errs Errors) Error() string {
 	return errs.Format(func(e *Error) string { return e.Error() }, "; ")
 }
+
+// Unwrap returns the errors, so errors.Is and errors.As can match any of them.
+func (errs Errors) Unwrap() []error {
+	out := make([]error, len(errs))
+	for i, e := range errs {
+		out[i] = e
+	}
+	return out
+}
+
+// Err returns the errors as an error if any of them fails the validation, or nil otherwise.
+// Unlike converting Errors to error, it returns an untyped nil on success.
+func (errs Errors) Err() error {
+	if !errs.HasErrors() {
+		return nil
+	}
+	return errs
+}
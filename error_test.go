@@ -1,6 +1,10 @@
 package validation_test
 
 import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/jacoelho/validation"
@@ -356,3 +360,167 @@ func TestNewErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorsSeverity(t *testing.T) {
+	errs := validation.Errors{
+		{Code: "deprecated", Severity: validation.SeverityWarning},
+		{Code: "typo", Severity: validation.SeverityInfo},
+	}
+
+	if errs.HasErrors() {
+		t.Error("expected warnings and infos not to count as errors")
+	}
+	if !errs.HasWarnings() {
+		t.Error("expected warnings")
+	}
+
+	errs = append(errs, &validation.Error{Code: "min"}, &validation.Error{Code: "zero", Fatal: true})
+
+	if !errs.HasErrors() {
+		t.Error("expected errors")
+	}
+	if !errs.HasFatalErrors() {
+		t.Error("expected fatal errors")
+	}
+
+	tests := []struct {
+		name string
+		got  validation.Errors
+		want []string
+	}{
+		{name: "warnings", got: errs.BySeverity(validation.SeverityWarning), want: []string{"deprecated"}},
+		{name: "infos and warnings", got: errs.BySeverity(validation.SeverityInfo, validation.SeverityWarning), want: []string{"deprecated", "typo"}},
+		{name: "fatal", got: errs.BySeverity(validation.SeverityFatal), want: []string{"zero"}},
+		{name: "blocking", got: errs.Blocking(), want: []string{"min", "zero"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var codes []string
+			for _, e := range tt.got {
+				codes = append(codes, e.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, codes)
+			}
+		})
+	}
+}
+
+func TestSeverityString(t *testing.T) {
+	tests := map[validation.Severity]string{
+		validation.SeverityInfo:    "info",
+		validation.SeverityWarning: "warning",
+		validation.SeverityError:   "error",
+		validation.SeverityFatal:   "fatal",
+		validation.Severity(5):     "severity(5)",
+	}
+	for severity, want := range tests {
+		if got := severity.String(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	}
+
+	if got := (&validation.Error{Fatal: true}).Level(); got != validation.SeverityFatal {
+		t.Errorf("expected fatal level, got %v", got)
+	}
+}
+
+func TestErrorJSONSeverity(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *validation.Error
+		want string
+	}{
+		{name: "error", err: &validation.Error{Code: "zero"}, want: `{"Field":"","Code":"zero","Params":null,"Fatal":false}`},
+		{name: "warning", err: &validation.Error{Code: "zero", Severity: validation.SeverityWarning}, want: `{"Field":"","Code":"zero","Params":null,"Fatal":false,"Severity":"warning"}`},
+		{name: "fatal flag", err: &validation.Error{Code: "zero", Fatal: true}, want: `{"Field":"","Code":"zero","Params":null,"Fatal":true,"Severity":"fatal"}`},
+		{name: "fatal severity", err: &validation.Error{Code: "zero", Severity: validation.SeverityFatal}, want: `{"Field":"","Code":"zero","Params":null,"Fatal":true,"Severity":"fatal"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.err)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, data)
+			}
+
+			var decoded struct{ Severity validation.Severity }
+			if err := json.Unmarshal(data, &decoded); err != nil || decoded.Severity != tt.err.Level() {
+				t.Errorf("expected severity %v, got %v (%v)", tt.err.Level(), decoded.Severity, err)
+			}
+		})
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Age", func(u User) int { return u.Age },
+			validation.NumbersMin(18),
+		),
+		validation.Field("Name", func(u User) string { return u.Name },
+			validation.StringsParse[string](strconv.Atoi),
+		),
+	)
+
+	err := validator.Check(User{Age: 10, Name: "abc"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, validation.ErrCode("min")) {
+		t.Errorf("expected min error, got %v", err)
+	}
+	if errors.Is(err, validation.ErrCode("max")) {
+		t.Errorf("unexpected max error in %v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected parse cause, got %v", err)
+	}
+
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) || numErr.Num != "abc" {
+		t.Errorf("expected strconv.NumError, got %v", err)
+	}
+
+	var validationErr *validation.Error
+	if !errors.As(err, &validationErr) || validationErr.Field != "Age" {
+		t.Errorf("expected first validation error, got %v", validationErr)
+	}
+
+	want := `min (field: Age) {actual: 10, min: 18}; parse (field: Name): strconv.Atoi: parsing "abc": invalid syntax`
+	if got := err.Error(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestErrorsErr(t *testing.T) {
+	if err := validation.Errors(nil).Err(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	warning := &validation.Error{Code: "min", Severity: validation.SeverityWarning}
+	if err := (validation.Errors{warning}).Err(); err != nil {
+		t.Errorf("expected nil for warnings, got %v", err)
+	}
+
+	errs := validation.Errors{warning, {Code: "required"}}
+	if err := errs.Err(); err == nil || !errors.Is(err, validation.ErrCode("required")) {
+		t.Errorf("expected required error, got %v", err)
+	}
+
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name }, validation.NotZero[string]()),
+	)
+	if err := validator.Check(User{Name: "John"}); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+	if err := validation.Slices(validation.SlicesMinLength[int](1)).Check(nil); !errors.Is(err, validation.ErrCode("min")) {
+		t.Errorf("expected min error, got %v", err)
+	}
+	if err := validation.Maps(validation.MapsMinKeys[string, int](1)).Check(map[string]int{"a": 1}); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package validation
+
+import "slices"
+
+// DefaultGroup is the group of fields and rules without explicit groups.
+// Validate runs the default group only.
+const DefaultGroup = "default"
+
+// groupRule is a rule that only runs when its group is active.
+type groupRule[F any] struct {
+	group string
+	rule  Rule[F]
+}
+
+// Groups returns a copy of the field that only runs when one of the given groups is active.
+// Fields without groups belong to DefaultGroup.
+func (fa FieldAccessor[T, F]) Groups(groups ...string) FieldAccessor[T, F] {
+	fa.groups = append(slices.Clip(fa.groups), groups...)
+	return fa
+}
+
+// GroupRules returns a copy of the field with rules that only run when the group is active,
+// regardless of the groups of the field. They run after the rules of the field.
+func (fa FieldAccessor[T, F]) GroupRules(group string, rules ...Rule[F]) FieldAccessor[T, F] {
+	fa.groupRules = slices.Clip(fa.groupRules)
+	for _, rule := range rules {
+		fa.groupRules = append(fa.groupRules, groupRule[F]{group: group, rule: rule})
+	}
+	return fa
+}
+
+// ValidateGroups validates the given value running only the fields and rules of the given groups.
+// The groups are propagated to nested struct, slice and map validators.
+// Nested fields of fields without groups run in their own groups, while the rules of such fields
+// run in DefaultGroup only.
+// Include DefaultGroup to also run fields and rules without groups.
+func (v *StructValidator[T]) ValidateGroups(value T, groups ...string) Errors {
+	return v.ValidateWithOptions(value, WithGroups(groups...))
+}
+
+// ValidateGroupSequence validates the given value one group at a time, in order,
+// stopping at the first group that reports errors.
+// Warnings and informational diagnostics do not stop the sequence and are returned
+// with the errors of the groups validated.
+func (v *StructValidator[T]) ValidateGroupSequence(value T, groups ...string) Errors {
+	var out Errors
+	for _, group := range groups {
+		errs := v.ValidateGroups(value, group)
+		out = append(out, errs...)
+		if errs.HasErrors() {
+			break
+		}
+	}
+	return out
+}
+
+// fieldsValidator is implemented by validators with nested fields, such as the validators
+// of StructField, PtrStructField, UnionField and SliceStructField, whose fields run in their own groups.
+type fieldsValidator[T any] interface {
+	hasFields() bool
+	validateFields(st *state, value T) Errors
+}
+
+// passesThrough reports whether the field has no groups and nested fields,
+// which are validated whenever the groups of the nested fields are active.
+func (fa FieldAccessor[T, F]) passesThrough() bool {
+	if len(fa.groups) > 0 {
+		return false
+	}
+	fv, ok := fa.inner.(fieldsValidator[F])
+	return ok && fv.hasFields()
+}
+
+// inGroups reports whether any of the groups is active.
+// An empty list of groups means DefaultGroup.
+func (st *state) inGroups(groups []string) bool {
+	if len(groups) == 0 {
+		return st.inGroup(DefaultGroup)
+	}
+	for _, group := range groups {
+		if st.inGroup(group) {
+			return true
+		}
+	}
+	return false
+}
+
+// inGroup reports whether the group is active.
+func (st *state) inGroup(group string) bool {
+	if st.groups == nil {
+		return group == DefaultGroup
+	}
+	return slices.Contains(st.groups, group)
+}
@@ -0,0 +1,154 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func TestValidateGroups(t *testing.T) {
+	type Account struct {
+		ID       int
+		Name     string
+		Password string
+		Address  Address
+		Billing  *Address
+		Payment  paymentMethod
+		Tags     []Address
+	}
+
+	address := validation.Struct(
+		validation.Field("City", func(a Address) string { return a.City },
+			validation.NotZero[string](),
+		).Groups("create"),
+		validation.Field("Street", func(a Address) string { return a.Street },
+			validation.NotZero[string](),
+		),
+	)
+
+	validator := validation.Struct(
+		validation.Field("ID", func(a Account) int { return a.ID },
+			validation.NumbersPositive[int](),
+		).Groups("update"),
+		validation.Field("Name", func(a Account) string { return a.Name },
+			validation.NotZero[string](),
+		).GroupRules("create", validation.StringsRuneMinLength[string](3)),
+		validation.Field("Password", func(a Account) string { return a.Password },
+			validation.NotZero[string](),
+		).Groups("create"),
+		validation.StructField("Address", func(a Account) Address { return a.Address }, address),
+		validation.PtrStructField("Billing", func(a Account) *Address { return a.Billing }, address),
+		validation.UnionField("Payment", func(a Account) paymentMethod { return a.Payment },
+			validation.PtrCase[paymentMethod](validation.Struct(
+				validation.Field("Number", func(c card) string { return c.Number },
+					validation.NotZero[string](),
+				).Groups("create"),
+			)),
+		),
+		validation.SliceStructField("Tags", func(a Account) []Address { return a.Tags }, address,
+			validation.SlicesMaxLength[Address](0),
+		),
+	)
+
+	account := Account{Name: "", Billing: &Address{}, Payment: &card{}, Tags: []Address{{}}}
+
+	fields := func(errs validation.Errors) []string {
+		var out []string
+		for _, err := range errs {
+			out = append(out, err.Code+":"+err.Field)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		groups []string
+		want   []string
+	}{
+		{
+			name: "default group",
+			want: []string{"zero:Name", "zero:Address.Street", "zero:Billing.Street", "max:Tags", "zero:Tags.0.Street"},
+		},
+		{
+			name:   "create group",
+			groups: []string{"create"},
+			want: []string{
+				"min:Name", "zero:Password", "zero:Address.City",
+				"zero:Billing.City", "zero:Payment.Number", "zero:Tags.0.City",
+			},
+		},
+		{
+			name:   "update group",
+			groups: []string{"update"},
+			want:   []string{"positive:ID"},
+		},
+		{
+			name:   "default and create groups",
+			groups: []string{validation.DefaultGroup, "create"},
+			want: []string{
+				"zero:Name", "min:Name", "zero:Password",
+				"zero:Address.City", "zero:Address.Street",
+				"zero:Billing.City", "zero:Billing.Street", "zero:Payment.Number",
+				"max:Tags", "zero:Tags.0.City", "zero:Tags.0.Street",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs validation.Errors
+			if tt.groups == nil {
+				errs = validator.Validate(account)
+			} else {
+				errs = validator.ValidateGroups(account, tt.groups...)
+			}
+			if got := fields(errs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateGroupSequence(t *testing.T) {
+	type Login struct {
+		Email    string
+		Password string
+	}
+
+	validator := validation.Struct(
+		validation.Field("Email", func(l Login) string { return l.Email },
+			validation.NotZero[string](),
+		),
+		validation.Field("Password", func(l Login) string { return l.Password },
+			validation.StringsRuneMinLength[string](8),
+		).Groups("strict"),
+	)
+
+	errs := validator.ValidateGroupSequence(Login{Password: "short"}, validation.DefaultGroup, "strict")
+	if len(errs) != 1 || errs[0].Field != "Email" {
+		t.Errorf("expected sequence to stop at default group, got %v", errs)
+	}
+
+	errs = validator.ValidateGroupSequence(Login{Email: "a@b.c", Password: "short"}, validation.DefaultGroup, "strict")
+	if len(errs) != 1 || errs[0].Field != "Password" {
+		t.Errorf("expected strict group error, got %v", errs)
+	}
+
+	if errs := validator.ValidateGroupSequence(Login{Email: "a@b.c", Password: "long enough"}, validation.DefaultGroup, "strict"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	warned := validation.Struct(
+		validation.Field("Email", func(l Login) string { return l.Email },
+			validation.WarnOnly(validation.StringsContains[string](".")),
+		).Groups("a"),
+		validation.Field("Password", func(l Login) string { return l.Password },
+			validation.NotZero[string](),
+		).Groups("b"),
+	)
+	errs = warned.ValidateGroupSequence(Login{Email: "a@b"}, "a", "b")
+	if got, want := errorSummary(errs), []string{"contains:Email", "zero:Password"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected warnings not to stop the sequence, got %v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package validation
+
+import "iter"
+
+// ValidateSeq returns an iterator over the errors of the value with the given options.
+// The value is validated lazily: errors are yielded as they are found,
+// and breaking out of the loop stops the validation.
+func (v *StructValidator[T]) ValidateSeq(value T, opts ...Option) iter.Seq[*Error] {
+	return validateSeq(v.validate, value, opts)
+}
+
+// IsValid reports whether the value has no errors, ignoring warnings and informational diagnostics.
+// The validation stops at the first error.
+func (v *StructValidator[T]) IsValid(value T) bool {
+	return isValid(v.validate, value)
+}
+
+// ValidateSeq returns an iterator over the errors of the values with the given options,
+// like StructValidator.ValidateSeq.
+func (v *SliceValidator[T]) ValidateSeq(values []T, opts ...Option) iter.Seq[*Error] {
+	return validateSeq(v.validate, values, opts)
+}
+
+// IsValid reports whether the values have no errors, like StructValidator.IsValid.
+func (v *SliceValidator[T]) IsValid(values []T) bool {
+	return isValid(v.validate, values)
+}
+
+// ValidateSeq returns an iterator over the errors of the values with the given options,
+// like StructValidator.ValidateSeq.
+func (v *MapValidator[K, V]) ValidateSeq(values map[K]V, opts ...Option) iter.Seq[*Error] {
+	return validateSeq(v.validate, values, opts)
+}
+
+// IsValid reports whether the values have no errors, like StructValidator.IsValid.
+func (v *MapValidator[K, V]) IsValid(values map[K]V) bool {
+	return isValid(v.validate, values)
+}
+
+// validateSeq returns an iterator over the errors of the value validated by validate.
+func validateSeq[T any](validate func(*state, T) Errors, value T, opts []Option) iter.Seq[*Error] {
+	return func(yield func(*Error) bool) {
+		st := newState(opts...)
+		defer st.release()
+		st.yield = yield
+		if st.panics == PanicRepanic {
+			st.yield = func(err *Error) bool {
+				st.repanic(err)
+				return yield(err)
+			}
+		}
+		validate(st, value)
+		if st.stopped {
+			return
+		}
+		for _, err := range st.finish(nil) {
+			if !yield(err) {
+				return
+			}
+		}
+	}
+}
+
+// isValid reports whether validate finds no errors in the value, stopping at the first error.
+func isValid[T any](validate func(*state, T) Errors, value T) bool {
+	st := newState()
+	defer st.release()
+	st.limit = 1
+	validate(st, value)
+	return st.errors == 0
+}
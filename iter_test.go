@@ -0,0 +1,205 @@
+package validation_test
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func TestValidateSeq(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](2)),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		).MaxErrors(2),
+	)
+	value := bulk{Items: make([]Address, 5)}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+	}{
+		{
+			name: "all errors",
+			want: errorSummary(validator.Validate(value)),
+		},
+		{
+			name:  "break",
+			limit: 3,
+			want:  []string{"zero:Name", "min:Name", "zero:Items.0.City"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for err := range validator.ValidateSeq(value) {
+				got = append(got, err.Code+":"+err.Field)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateSeqStopsValidation(t *testing.T) {
+	var evaluated int
+	counted := func(value string) *validation.Error {
+		evaluated++
+		if value == "" {
+			return &validation.Error{Code: "zero"}
+		}
+		return nil
+	}
+	validator := validation.Struct(
+		validation.SliceStructField("Codes", func(b bulk) []string { return b.Codes },
+			validation.Value(counted),
+		),
+	)
+
+	for range validator.ValidateSeq(bulk{Codes: make([]string, 10)}) {
+		break
+	}
+	if evaluated != 1 {
+		t.Errorf("expected 1 rule evaluation, got %d", evaluated)
+	}
+}
+
+func TestValidateSeqOptions(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+		),
+		validation.Field("Email", func(b bulk) string { return b.Email },
+			validation.NotZero[string](),
+		),
+	)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		opts []validation.Option
+		want []string
+	}{
+		{name: "fail fast", opts: []validation.Option{validation.FailFast()}, want: []string{"zero:Name", "truncated:"}},
+		{name: "canceled", opts: []validation.Option{validation.WithContext(canceled)}, want: []string{"canceled:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for err := range validator.ValidateSeq(bulk{}, tt.opts...) {
+				got = append(got, err.Code+":"+err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](2)),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		),
+	)
+
+	tests := []struct {
+		name  string
+		value bulk
+		want  bool
+	}{
+		{name: "valid", value: bulk{Name: "John"}, want: true},
+		{name: "warnings only", value: bulk{Name: "J"}, want: true},
+		{name: "invalid", value: bulk{}, want: false},
+		{name: "invalid element", value: bulk{Name: "John", Items: make([]Address, 1)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validator.IsValid(tt.value); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCollectionValidateSeq(t *testing.T) {
+	address := validation.Struct(
+		validation.Field("City", func(a Address) string { return a.City },
+			validation.NotZero[string](),
+		),
+	)
+	slices := validation.Slices(validation.SlicesMaxLength[Address](1)).Each(address)
+	maps := validation.Maps(validation.MapsMaxKeys[string, Address](1)).Each(address)
+
+	tests := []struct {
+		name    string
+		seq     func() iter.Seq[*validation.Error]
+		isValid func() bool
+		want    []string
+	}{
+		{
+			name:    "invalid slice",
+			seq:     func() iter.Seq[*validation.Error] { return slices.ValidateSeq(make([]Address, 2)) },
+			isValid: func() bool { return slices.IsValid(make([]Address, 2)) },
+			want:    []string{"max:", "zero:0.City", "zero:1.City"},
+		},
+		{
+			name:    "valid slice",
+			seq:     func() iter.Seq[*validation.Error] { return slices.ValidateSeq([]Address{{City: "Lisbon"}}) },
+			isValid: func() bool { return slices.IsValid([]Address{{City: "Lisbon"}}) },
+		},
+		{
+			name:    "invalid map",
+			seq:     func() iter.Seq[*validation.Error] { return maps.ValidateSeq(map[string]Address{"a": {}, "b": {}}) },
+			isValid: func() bool { return maps.IsValid(map[string]Address{"a": {}, "b": {}}) },
+			want:    []string{"max:", "zero:a.City", "zero:b.City"},
+		},
+		{
+			name:    "valid map",
+			seq:     func() iter.Seq[*validation.Error] { return maps.ValidateSeq(map[string]Address{"a": {City: "Lisbon"}}) },
+			isValid: func() bool { return maps.IsValid(map[string]Address{"a": {City: "Lisbon"}}) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for err := range tt.seq() {
+				got = append(got, err.Code+":"+err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			if valid := tt.isValid(); valid != (len(tt.want) == 0) {
+				t.Errorf("expected IsValid %v, got %v", len(tt.want) == 0, valid)
+			}
+		})
+	}
+}
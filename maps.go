@@ -2,6 +2,8 @@ package validation
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // MapRule is a function that validates a map of values.
@@ -13,6 +15,7 @@ type MapEntryRule[K comparable, V any] func(key K, value V) *Error
 // MapValidator is a validator for maps of values.
 type MapValidator[K comparable, V any] struct {
 	rules []MapRule[K, V]
+	each  *StructValidator[V]
 }
 
 // Maps creates a new MapValidator with the given rules.
@@ -20,54 +23,166 @@ func Maps[K comparable, V any](rules ...MapRule[K, V]) *MapValidator[K, V] {
 	return &MapValidator[K, V]{rules: rules}
 }
 
+// Each sets the validator applied to every value, as MapStructField does.
+// It returns the validator to allow chaining.
+func (v *MapValidator[K, V]) Each(validator *StructValidator[V]) *MapValidator[K, V] {
+	v.each = validator
+	return v
+}
+
 // Validate validates the given values.
 func (v *MapValidator[K, V]) Validate(values map[K]V) Errors {
 	return v.ValidateWithPrefix(values, "")
 }
 
+// Check validates the given values and returns the errors as an error,
+// or nil if there are no errors. Warnings and informational diagnostics alone return nil.
+func (v *MapValidator[K, V]) Check(values map[K]V) error {
+	return v.Validate(values).Err()
+}
+
 // ValidateWithPrefix validates the given values with a prefix.
 func (v *MapValidator[K, V]) ValidateWithPrefix(values map[K]V, prefix string) Errors {
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return v.validate(st, values)
+}
+
+func (v *MapValidator[K, V]) validate(st *state, values map[K]V) Errors {
 	var out Errors
-	for _, rule := range v.rules {
-		ruleErrs := rule(values)
-		for _, err := range ruleErrs {
-			if err.Field != "" {
-				err.Field = joinField(prefix, err.Field)
-			} else {
-				err.Field = prefix
-			}
+	for i, rule := range v.rules {
+		errs := evaluateAll(st, parallelMapRule(st, rule, values), values)
+		for j, err := range errs {
 			out = append(out, err)
-			if err.Fatal {
+			if st.stop(err) {
+				st.skip(j+1 < len(errs) || i+1 < len(v.rules) || v.each != nil && len(values) > 0)
 				return out
 			}
 		}
 	}
+
+	return append(out, v.validateFields(st, values)...)
+}
+
+// hasFields reports whether the values are validated with a struct validator.
+func (v *MapValidator[K, V]) hasFields() bool {
+	return v.each != nil
+}
+
+// validateFields validates each value with the struct validator of the values, if any.
+func (v *MapValidator[K, V]) validateFields(st *state, values map[K]V) Errors {
+	if v.each == nil {
+		return nil
+	}
+	keys := sortedKeys(values)
+	if st.concurrent(len(keys)) {
+		return eachParallel(st, values, keys, v.validateEntry)
+	}
+	var out Errors
+	for _, key := range keys {
+		if st.halted() {
+			break
+		}
+		out = append(out, v.validateValue(st, key, values[key])...)
+	}
 	return out
 }
 
+// validateEntry validates the value of the key with the validator of the values.
+func (v *MapValidator[K, V]) validateEntry(st *state, values map[K]V, key K, _ int) Errors {
+	return v.validateValue(st, key, values[key])
+}
+
+// validateValue validates the value of the key with the validator of the values.
+func (v *MapValidator[K, V]) validateValue(st *state, key K, value V) Errors {
+	name := fmt.Sprintf("%v", key)
+	st.push(name)
+	defer st.pop()
+	st.traceEnter(name)
+	defer st.traceLeave()
+	return v.each.validate(st, value)
+}
+
+// sortedKeys returns the keys of the map sorted by their formatted value.
+func sortedKeys[K comparable, V any](values map[K]V) []K {
+	keys := make([]K, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b K) int {
+		return strings.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+	})
+	return keys
+}
+
 // MapsForEach validates each entry in the map using the given rules.
 func MapsForEach[K comparable, V any](rules ...MapEntryRule[K, V]) MapRule[K, V] {
-	return func(values map[K]V) Errors {
+	entry := eachEntry[K, V](func(k K, v V) Errors {
 		var errs Errors
-		for k, v := range values {
-			for _, rule := range rules {
-				if err := rule(k, v); err != nil {
-					err.Field = fmt.Sprintf("%v", k)
-					errs = append(errs, err)
-					if err.Fatal {
-						return errs
-					}
+		for _, rule := range rules {
+			if err := rule(k, v); err != nil {
+				err.Field = fmt.Sprintf("%v", k)
+				err.reject(v)
+				errs = append(errs, err)
+				if err.isFatal() {
+					return errs
 				}
 			}
 		}
 		return errs
+	})
+	forEach := MapRule[K, V](func(values map[K]V) Errors {
+		var errs Errors
+		for k, v := range values {
+			if errs = append(errs, entry(k, v)...); stoppedAtFatal(errs) {
+				return errs
+			}
+		}
+		return errs
+	})
+	describeInfo(forEach, &ruleInfo{name: "MapsForEach", each: entry})
+	return forEach
+}
+
+// eachEntry validates an entry of a map, for rules created by MapsForEach.
+type eachEntry[K comparable, V any] func(key K, value V) Errors
+
+// parallelMapRule returns a rule validating the entries concurrently, in the order of their keys,
+// when the rule was created by MapsForEach and the entries are validated concurrently,
+// or otherwise the rule.
+func parallelMapRule[K comparable, V any](st *state, rule MapRule[K, V], values map[K]V) MapRule[K, V] {
+	if !st.concurrent(len(values)) {
+		return rule
+	}
+	info := describedRule(rule)
+	if info == nil {
+		return rule
+	}
+	entry, ok := info.each.(eachEntry[K, V])
+	if !ok {
+		return rule
+	}
+	return func(values map[K]V) Errors {
+		keys := sortedKeys(values)
+		results := make([]Errors, len(keys))
+		runParallel(st, len(keys), func(i int) {
+			results[i] = entry(keys[i], values[keys[i]])
+		})
+		return mergeResults(results)
 	}
 }
 
 // MapsMinKeys validates that the map has at least the given number of keys.
 func MapsMinKeys[K comparable, V any](min int) MapRule[K, V] {
+	return describe(MapsMinKeysFunc[K, V](func() int { return min }), "MapsMinKeys")
+}
+
+// MapsMinKeysFunc validates that the map has at least the number of keys returned by fn.
+// The minimum is resolved every time the rule is evaluated.
+func MapsMinKeysFunc[K comparable, V any](fn func() int) MapRule[K, V] {
 	return func(values map[K]V) Errors {
-		if len(values) < min {
+		if min := fn(); len(values) < min {
 			return SingleErrorSlice("", "min", map[string]any{"min": min, "actual": len(values)}, false)
 		}
 		return nil
@@ -76,25 +191,46 @@ func MapsMinKeys[K comparable, V any](min int) MapRule[K, V] {
 
 // MapsMaxKeys validates that the map has at most the given number of keys.
 func MapsMaxKeys[K comparable, V any](max int) MapRule[K, V] {
+	return describe(MapsMaxKeysFunc[K, V](func() int { return max }), "MapsMaxKeys")
+}
+
+// MapsMaxKeysFunc validates that the map has at most the number of keys returned by fn.
+// The maximum is resolved every time the rule is evaluated.
+func MapsMaxKeysFunc[K comparable, V any](fn func() int) MapRule[K, V] {
 	return func(values map[K]V) Errors {
-		if len(values) > max {
+		if max := fn(); len(values) > max {
 			return SingleErrorSlice("", "max", map[string]any{"max": max, "actual": len(values)}, false)
 		}
 		return nil
 	}
 }
 
+// MapsLength validates that the map has exactly the given number of keys.
 func MapsLength[K comparable, V any](length int) MapRule[K, V] {
+	return describe(MapsLengthFunc[K, V](func() int { return length }), "MapsLength")
+}
+
+// MapsLengthFunc validates that the map has exactly the number of keys returned by fn.
+// The length is resolved every time the rule is evaluated.
+func MapsLengthFunc[K comparable, V any](fn func() int) MapRule[K, V] {
 	return func(values map[K]V) Errors {
-		if len(values) != length {
+		if length := fn(); len(values) != length {
 			return SingleErrorSlice("", "length", map[string]any{"length": length, "actual": len(values)}, false)
 		}
 		return nil
 	}
 }
 
+// MapsLengthBetween validates that the number of keys is between the given bounds.
 func MapsLengthBetween[K comparable, V any](min, max int) MapRule[K, V] {
+	return describe(MapsLengthBetweenFunc[K, V](func() (int, int) { return min, max }), "MapsLengthBetween")
+}
+
+// MapsLengthBetweenFunc validates that the number of keys is between the bounds returned by fn.
+// The bounds are resolved every time the rule is evaluated.
+func MapsLengthBetweenFunc[K comparable, V any](fn func() (min, max int)) MapRule[K, V] {
 	return func(values map[K]V) Errors {
+		min, max := fn()
 		if len(values) < min || len(values) > max {
 			return SingleErrorSlice("", "between", map[string]any{"min": min, "max": max, "actual": len(values)}, false)
 		}
@@ -177,9 +313,10 @@ func MapsKey[K comparable, V any](key K, rules ...Rule[V]) MapRule[K, V] {
 		for _, rule := range rules {
 			err := rule(v)
 			if err != nil {
-				err.Field = fmt.Sprintf("%v", key)
+				err.nest(fmt.Sprintf("%v", key))
+				err.reject(v)
 				errs = append(errs, err)
-				if err.Fatal {
+				if err.isFatal() {
 					return errs
 				}
 			}
@@ -187,3 +324,68 @@ func MapsKey[K comparable, V any](key K, rules ...Rule[V]) MapRule[K, V] {
 		return errs
 	}
 }
+
+// MapsMinKeysE is like MapsMinKeys but returns an error if the minimum is negative.
+func MapsMinKeysE[K comparable, V any](min int) (MapRule[K, V], error) {
+	if err := checkLength("MapsMinKeys", "min", min); err != nil {
+		return nil, err
+	}
+	return MapsMinKeys[K, V](min), nil
+}
+
+// MapsMaxKeysE is like MapsMaxKeys but returns an error if the maximum is negative.
+func MapsMaxKeysE[K comparable, V any](max int) (MapRule[K, V], error) {
+	if err := checkLength("MapsMaxKeys", "max", max); err != nil {
+		return nil, err
+	}
+	return MapsMaxKeys[K, V](max), nil
+}
+
+// MapsLengthE is like MapsLength but returns an error if the length is negative.
+func MapsLengthE[K comparable, V any](length int) (MapRule[K, V], error) {
+	if err := checkLength("MapsLength", "length", length); err != nil {
+		return nil, err
+	}
+	return MapsLength[K, V](length), nil
+}
+
+// MapsLengthBetweenE is like MapsLengthBetween but returns an error
+// if a bound is negative or min is greater than max.
+func MapsLengthBetweenE[K comparable, V any](min, max int) (MapRule[K, V], error) {
+	if err := checkLengthRange("MapsLengthBetween", min, max); err != nil {
+		return nil, err
+	}
+	return MapsLengthBetween[K, V](min, max), nil
+}
+
+// MapsKeysOneOfE is like MapsKeysOneOf but returns an error if no allowed keys are given.
+func MapsKeysOneOfE[K comparable, V any](allowed ...K) (MapRule[K, V], error) {
+	if err := checkNotEmpty("MapsKeysOneOf", allowed); err != nil {
+		return nil, err
+	}
+	return MapsKeysOneOf[K, V](allowed...), nil
+}
+
+// MapsKeysNotOneOfE is like MapsKeysNotOneOf but returns an error if no disallowed keys are given.
+func MapsKeysNotOneOfE[K comparable, V any](disallowed ...K) (MapRule[K, V], error) {
+	if err := checkNotEmpty("MapsKeysNotOneOf", disallowed); err != nil {
+		return nil, err
+	}
+	return MapsKeysNotOneOf[K, V](disallowed...), nil
+}
+
+// MapsValuesOneOfE is like MapsValuesOneOf but returns an error if no allowed values are given.
+func MapsValuesOneOfE[K comparable, V comparable](allowed ...V) (MapRule[K, V], error) {
+	if err := checkNotEmpty("MapsValuesOneOf", allowed); err != nil {
+		return nil, err
+	}
+	return MapsValuesOneOf[K](allowed...), nil
+}
+
+// MapsValuesNotOneOfE is like MapsValuesNotOneOf but returns an error if no disallowed values are given.
+func MapsValuesNotOneOfE[K comparable, V comparable](disallowed ...V) (MapRule[K, V], error) {
+	if err := checkNotEmpty("MapsValuesNotOneOf", disallowed); err != nil {
+		return nil, err
+	}
+	return MapsValuesNotOneOf[K](disallowed...), nil
+}
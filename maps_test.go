@@ -758,3 +758,30 @@ func TestMapsKeyValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMapsFunc(t *testing.T) {
+	limit := 1
+	values := map[string]int{"a": 1, "b": 2}
+
+	rules := map[string]validation.MapRule[string, int]{
+		"max":     validation.MapsMaxKeysFunc[string, int](func() int { return limit }),
+		"between": validation.MapsLengthBetweenFunc[string, int](func() (int, int) { return 0, limit }),
+		"length":  validation.MapsLengthFunc[string, int](func() int { return limit }),
+	}
+	for name, rule := range rules {
+		if errs := rule(values); len(errs) != 1 || errs[0].Code != name {
+			t.Errorf("%s: expected %s error, got %v", name, name, errs)
+		}
+	}
+
+	limit = 2
+	for name, rule := range rules {
+		if errs := rule(values); len(errs) != 0 {
+			t.Errorf("%s: expected no errors after changing limit, got %v", name, errs)
+		}
+	}
+
+	if errs := validation.MapsMinKeysFunc[string, int](func() int { return limit + 1 })(values); len(errs) != 1 || errs[0].Code != "min" {
+		t.Errorf("expected min error, got %v", errs)
+	}
+}
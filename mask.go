@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// FieldMask is a set of field paths, such as "Address.City".
+// A path includes every field nested under it.
+type FieldMask map[string]struct{}
+
+// NewFieldMask creates a FieldMask with the given paths.
+func NewFieldMask(paths ...string) FieldMask {
+	mask := make(FieldMask, len(paths))
+	for _, path := range paths {
+		mask[path] = struct{}{}
+	}
+	return mask
+}
+
+// FieldMaskFromJSON creates a FieldMask with the paths of the members present in a JSON object.
+// Nested objects contribute the paths of their members, while any other value,
+// including arrays and empty objects, contributes its own path.
+// Paths use the JSON member names, so fields must be named accordingly.
+func FieldMaskFromJSON(data []byte) (FieldMask, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	mask := make(FieldMask)
+	if err := mask.addJSON("", object); err != nil {
+		return nil, err
+	}
+	return mask, nil
+}
+
+// addJSON adds the paths of the members of the object under the prefix.
+func (m FieldMask) addJSON(prefix string, object map[string]json.RawMessage) error {
+	for name, raw := range object {
+		path := joinField(prefix, name)
+		var nested map[string]json.RawMessage
+		if len(raw) > 0 && raw[0] == '{' {
+			if err := json.Unmarshal(raw, &nested); err != nil {
+				return err
+			}
+		}
+		if len(nested) == 0 {
+			m[path] = struct{}{}
+			continue
+		}
+		if err := m.addJSON(path, nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// match reports whether the path is included by the mask, either directly
+// or through one of its parents, and whether it is the parent of an included path.
+func (m FieldMask) match(path string) (included, parent bool) {
+	if m == nil {
+		return true, false
+	}
+	for p := path; ; {
+		if _, ok := m[p]; ok {
+			return true, false
+		}
+		i := strings.LastIndexByte(p, '.')
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+	if path == "" {
+		return false, len(m) > 0
+	}
+	for p := range m {
+		if strings.HasPrefix(p, path+".") {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// ValidatePaths validates the given value running only the fields included by the mask.
+// Fields that are parents of included paths are not validated themselves,
+// but their nested validators are. Struct rules run when they reference an included
+// field or when they reference no fields.
+// Paths are made of field names; indexes and keys of slices and maps are not part of them.
+func (v *StructValidator[T]) ValidatePaths(value T, mask FieldMask) Errors {
+	return v.ValidateWithOptions(value, WithFieldMask(mask))
+}
+
+// StructRule creates a new FieldAccessor that applies the rule to the whole struct.
+// The fields are the names of the fields the rule depends on, used by ValidatePaths.
+// Errors are reported at the struct path unless the rule sets a field.
+func StructRule[T any](rule Rule[T], fields ...string) FieldAccessor[T, T] {
+	return FieldAccessor[T, T]{
+		get:        func(value T) T { return value },
+		rules:      []Rule[T]{rule},
+		refs:       fields,
+		keepFields: true,
+	}
+}
@@ -0,0 +1,121 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func TestValidatePaths(t *testing.T) {
+	type Profile struct {
+		Name     string
+		Email    string
+		Password string
+		Confirm  string
+		Address  Address
+		Previous []Address
+	}
+
+	address := validation.Struct(
+		validation.Field("City", func(a Address) string { return a.City }, validation.NotZero[string]()),
+		validation.Field("Street", func(a Address) string { return a.Street }, validation.NotZero[string]()),
+	)
+
+	validator := validation.Struct(
+		validation.Field("Name", func(p Profile) string { return p.Name }, validation.NotZero[string]()),
+		validation.Field("Email", func(p Profile) string { return p.Email }, validation.NotZero[string]()),
+		validation.StructField("Address", func(p Profile) Address { return p.Address }, address),
+		validation.SliceStructField("Previous", func(p Profile) []Address { return p.Previous }, address),
+		validation.StructRule(func(p Profile) *validation.Error {
+			if p.Password != p.Confirm {
+				return &validation.Error{Code: "mismatch", Field: "Confirm"}
+			}
+			return nil
+		}, "Password", "Confirm"),
+	)
+
+	profile := Profile{Password: "a", Confirm: "b", Previous: []Address{{}}}
+
+	codes := func(errs validation.Errors) []string {
+		var out []string
+		for _, err := range errs {
+			out = append(out, err.Code+":"+err.Field)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		mask validation.FieldMask
+		want []string
+	}{
+		{
+			name: "single field",
+			mask: validation.NewFieldMask("Email"),
+			want: []string{"zero:Email"},
+		},
+		{
+			name: "nested field",
+			mask: validation.NewFieldMask("Address.City"),
+			want: []string{"zero:Address.City"},
+		},
+		{
+			name: "parent includes nested fields",
+			mask: validation.NewFieldMask("Address"),
+			want: []string{"zero:Address.City", "zero:Address.Street"},
+		},
+		{
+			name: "slice element fields",
+			mask: validation.NewFieldMask("Previous.Street"),
+			want: []string{"zero:Previous.0.Street"},
+		},
+		{
+			name: "struct rule referencing masked field",
+			mask: validation.NewFieldMask("Confirm"),
+			want: []string{"mismatch:Confirm"},
+		},
+		{
+			name: "empty mask",
+			mask: validation.NewFieldMask(),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := codes(validator.ValidatePaths(profile, tt.mask))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("struct rule runs without mask", func(t *testing.T) {
+		errs := validator.Validate(profile)
+		if got := codes(errs); len(got) != 7 || got[6] != "mismatch:Confirm" {
+			t.Errorf("expected all errors including mismatch, got %v", got)
+		}
+	})
+}
+
+func TestFieldMaskFromJSON(t *testing.T) {
+	mask, err := validation.FieldMaskFromJSON([]byte(`{
+		"name": "John",
+		"address": {"city": "Lisbon", "geo": {"lat": 1}},
+		"tags": ["a"],
+		"meta": {}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := validation.NewFieldMask("name", "address.city", "address.geo.lat", "tags", "meta")
+	if !reflect.DeepEqual(mask, want) {
+		t.Errorf("expected %v, got %v", want, mask)
+	}
+
+	if _, err := validation.FieldMaskFromJSON([]byte(`[1]`)); err == nil {
+		t.Error("expected error for non-object JSON")
+	}
+}
@@ -6,8 +6,14 @@ import (
 
 // NumbersMin validates that the value is greater than or equal to the given minimum.
 func NumbersMin[T cmp.Ordered](min T) Rule[T] {
+	return describe(NumbersMinFunc(func() T { return min }), "NumbersMin")
+}
+
+// NumbersMinFunc validates that the value is greater than or equal to the minimum returned by fn.
+// The minimum is resolved every time the rule is evaluated.
+func NumbersMinFunc[T cmp.Ordered](fn func() T) Rule[T] {
 	return func(value T) *Error {
-		if value < min {
+		if min := fn(); value < min {
 			return &Error{
 				Code:   "min",
 				Params: map[string]any{"min": min, "actual": value},
@@ -19,8 +25,14 @@ func NumbersMin[T cmp.Ordered](min T) Rule[T] {
 
 // NumbersMax validates that the value is less than or equal to the given maximum.
 func NumbersMax[T cmp.Ordered](max T) Rule[T] {
+	return describe(NumbersMaxFunc(func() T { return max }), "NumbersMax")
+}
+
+// NumbersMaxFunc validates that the value is less than or equal to the maximum returned by fn.
+// The maximum is resolved every time the rule is evaluated.
+func NumbersMaxFunc[T cmp.Ordered](fn func() T) Rule[T] {
 	return func(value T) *Error {
-		if value > max {
+		if max := fn(); value > max {
 			return &Error{
 				Code:   "max",
 				Params: map[string]any{"max": max, "actual": value},
@@ -32,7 +44,14 @@ func NumbersMax[T cmp.Ordered](max T) Rule[T] {
 
 // NumbersBetween validates that the value is between the given minimum and maximum (inclusive).
 func NumbersBetween[T cmp.Ordered](min, max T) Rule[T] {
+	return describe(NumbersBetweenFunc(func() (T, T) { return min, max }), "NumbersBetween")
+}
+
+// NumbersBetweenFunc validates that the value is between the bounds returned by fn (inclusive).
+// The bounds are resolved every time the rule is evaluated.
+func NumbersBetweenFunc[T cmp.Ordered](fn func() (min, max T)) Rule[T] {
 	return func(value T) *Error {
+		min, max := fn()
 		if value < min || value > max {
 			return &Error{
 				Code:   "between",
@@ -86,3 +105,35 @@ func NumbersNonPositive[T cmp.Ordered]() Rule[T] {
 		return nil
 	}
 }
+
+// NumbersMinE is like NumbersMin but returns an error if the minimum is NaN.
+func NumbersMinE[T cmp.Ordered](min T) (Rule[T], error) {
+	if isNaN(min) {
+		return nil, invalidRule("NumbersMin", "min must not be NaN")
+	}
+	return NumbersMin(min), nil
+}
+
+// NumbersMaxE is like NumbersMax but returns an error if the maximum is NaN.
+func NumbersMaxE[T cmp.Ordered](max T) (Rule[T], error) {
+	if isNaN(max) {
+		return nil, invalidRule("NumbersMax", "max must not be NaN")
+	}
+	return NumbersMax(max), nil
+}
+
+// NumbersBetweenE is like NumbersBetween but returns an error if a bound is NaN or min is greater than max.
+func NumbersBetweenE[T cmp.Ordered](min, max T) (Rule[T], error) {
+	if isNaN(min) || isNaN(max) {
+		return nil, invalidRule("NumbersBetween", "bounds must not be NaN")
+	}
+	if err := checkRange("NumbersBetween", min, max); err != nil {
+		return nil, err
+	}
+	return NumbersBetween(min, max), nil
+}
+
+// isNaN reports whether the value is a floating point NaN.
+func isNaN[T cmp.Ordered](value T) bool {
+	return value != value
+}
@@ -1058,3 +1058,31 @@ func TestNumbersEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestNumbersFunc(t *testing.T) {
+	limit := 10
+	minRule := validation.NumbersMinFunc(func() int { return limit })
+	maxRule := validation.NumbersMaxFunc(func() int { return limit })
+	betweenRule := validation.NumbersBetweenFunc(func() (int, int) { return 0, limit })
+
+	if err := maxRule(15); err == nil || err.Code != "max" {
+		t.Fatalf("expected max error, got %v", err)
+	}
+	if err := minRule(5); err == nil || err.Code != "min" {
+		t.Fatalf("expected min error, got %v", err)
+	}
+	if err := betweenRule(15); err == nil || err.Params["max"] != 10 {
+		t.Fatalf("expected between error with max 10, got %v", err)
+	}
+
+	limit = 20
+	if err := maxRule(15); err != nil {
+		t.Errorf("expected no error after raising limit, got %v", err)
+	}
+	if err := minRule(15); err == nil || err.Params["min"] != 20 {
+		t.Errorf("expected min error with min 20, got %v", err)
+	}
+	if err := betweenRule(15); err != nil {
+		t.Errorf("expected no error after raising limit, got %v", err)
+	}
+}
@@ -0,0 +1,254 @@
+package validation
+
+import (
+	"expvar"
+	"sync"
+	"time"
+)
+
+// Observer is notified of the progress of a validation, e.g. to collect metrics.
+// Observers used with Parallel must be safe for concurrent use.
+type Observer interface {
+	// FieldStart is called before a field is validated.
+	FieldStart(event FieldEvent)
+	// FieldEnd is called after a field is validated, with the duration and the number of errors.
+	FieldEnd(event FieldEvent)
+	// Rule is called after a rule is evaluated, with the error if the rule failed.
+	Rule(event RuleEvent)
+	// Fatal is called when a fatal error stops the validation of a value.
+	Fatal(event RuleEvent)
+}
+
+// FieldEvent describes the validation of a field.
+type FieldEvent struct {
+	// Validator is the name of the closest named StructValidator, see StructValidator.Named.
+	Validator string
+	Field     string
+	Duration  time.Duration
+	Errors    int
+}
+
+// RuleEvent describes the evaluation of a rule.
+type RuleEvent struct {
+	// Validator is the name of the closest named StructValidator, see StructValidator.Named.
+	Validator string
+	Field     string
+	// Code is the code of the error, empty when the rule passed.
+	Code     string
+	Err      *Error
+	Duration time.Duration
+}
+
+// Passed reports whether the rule passed.
+func (e RuleEvent) Passed() bool {
+	return e.Err == nil
+}
+
+// WithObserver notifies the observer of the progress of the validation.
+// Without an observer, no timing or event is recorded.
+func WithObserver(observer Observer) Option {
+	return func(st *state) {
+		st.observer = observer
+	}
+}
+
+// NopObserver is an Observer that does nothing, to be embedded by observers
+// interested in some events only.
+type NopObserver struct{}
+
+// FieldStart implements Observer.
+func (NopObserver) FieldStart(FieldEvent) {}
+
+// FieldEnd implements Observer.
+func (NopObserver) FieldEnd(FieldEvent) {}
+
+// Rule implements Observer.
+func (NopObserver) Rule(RuleEvent) {}
+
+// Fatal implements Observer.
+func (NopObserver) Fatal(RuleEvent) {}
+
+// ExpvarObserver counts the failed rules in an expvar.Map,
+// keyed by validator name and then by error code.
+// Errors of unnamed validators are counted under "unnamed".
+type ExpvarObserver struct {
+	NopObserver
+	failures *expvar.Map
+	mu       sync.Mutex
+}
+
+// NewExpvarObserver creates an ExpvarObserver publishing its counters with the given name.
+// Like expvar.NewMap, it panics if the name is already published.
+func NewExpvarObserver(name string) *ExpvarObserver {
+	return &ExpvarObserver{failures: expvar.NewMap(name)}
+}
+
+// Rule implements Observer, counting the rule if it failed.
+func (o *ExpvarObserver) Rule(event RuleEvent) {
+	if event.Passed() {
+		return
+	}
+	o.validator(event.Validator).Add(event.Code, 1)
+}
+
+// Failures returns the published counters.
+func (o *ExpvarObserver) Failures() *expvar.Map {
+	return o.failures
+}
+
+// validator returns the counters of the validator, creating them if needed.
+func (o *ExpvarObserver) validator(name string) *expvar.Map {
+	if name == "" {
+		name = "unnamed"
+	}
+	if m, ok := o.failures.Get(name).(*expvar.Map); ok {
+		return m
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if m, ok := o.failures.Get(name).(*expvar.Map); ok {
+		return m
+	}
+	m := new(expvar.Map)
+	o.failures.Set(name, m)
+	return m
+}
+
+// evaluate applies the rule to the value, reporting the error at the path of the value,
+// and notifies the observer of the validation, if any.
+// The field set by the rule is joined to the path when keepFields is set, as for StructRule,
+// or when it was set for a part of the value, see Error.nest; otherwise it is replaced.
+func evaluate[F any](st *state, rule Rule[F], value F, keepFields bool) *Error {
+	if st.observer == nil && st.trace == nil {
+		err := callRule(st, rule, value)
+		if err != nil {
+			if st.captureValues {
+				err.capture(value)
+			}
+			st.locateRule(err, keepFields)
+		}
+		return err
+	}
+
+	start := time.Now()
+	var err *Error
+	skipped := false
+	if c, ok := traceCondition(st, rule); ok {
+		err = callRule(st, func(value F) *Error {
+			if c.holds(value) == c.unless {
+				skipped = true
+				return nil
+			}
+			return c.rule(value)
+		}, value)
+	} else {
+		err = callRule(st, rule, value)
+	}
+	if err != nil {
+		if st.captureValues {
+			err.capture(value)
+		}
+		st.locateRule(err, keepFields)
+	}
+	if st.observer != nil {
+		st.observeRule(err, time.Since(start))
+	}
+	if st.trace != nil {
+		if skipped {
+			st.trace.add(&traceNode{name: ruleName(rule), rule: true, skipped: "condition"})
+		} else {
+			st.trace.rule(ruleName(rule), value, err, st.sensitive)
+		}
+	}
+	return err
+}
+
+// evaluateAll applies a rule reporting several errors to the value, like evaluate.
+func evaluateAll[V any](st *state, rule func(V) Errors, value V) Errors {
+	var start time.Time
+	if st.observer != nil {
+		start = time.Now()
+	}
+	errs := callRuleAll(st, rule, value)
+	for _, err := range errs {
+		if st.captureValues {
+			err.capture(value)
+		}
+		st.locate(err)
+	}
+	if st.observer != nil {
+		duration := time.Since(start)
+		if len(errs) == 0 {
+			st.observeRule(nil, duration)
+		}
+		for _, err := range errs {
+			st.observeRule(err, duration)
+		}
+	}
+	if st.trace != nil {
+		if len(errs) == 0 {
+			st.trace.rule(ruleName(rule), value, nil, st.sensitive)
+		}
+		for _, err := range errs {
+			st.trace.rule(ruleName(rule), value, err, st.sensitive)
+		}
+	}
+	return errs
+}
+
+// locate joins the path of the value being validated to the field of the error,
+// and marks the error as sensitive when the value is.
+func (st *state) locate(err *Error) {
+	err.Field = st.fieldPath(err.Field)
+	if st.sensitive {
+		err.Sensitive = true
+	}
+	err.rejected, err.hasRejected = nil, false
+	err.nested = false
+}
+
+// locateRule locates the error of a rule applied to a single value, like locate,
+// clearing its field first unless it is kept, see evaluate.
+func (st *state) locateRule(err *Error, keepFields bool) {
+	if !keepFields && !err.nested {
+		err.Field = ""
+	}
+	st.locate(err)
+}
+
+// capture marks an error of the value being validated as sensitive when the value is,
+// and records the value in the error when values are captured, like evaluate does for the errors of rules.
+func (st *state) capture(err *Error, value any) {
+	if st.sensitive {
+		err.Sensitive = true
+	}
+	if st.captureValues {
+		err.capture(value)
+	}
+}
+
+// observeRule notifies the observer of the evaluation of a rule.
+func (st *state) observeRule(err *Error, duration time.Duration) {
+	event := RuleEvent{Validator: st.validator, Duration: duration}
+	if err != nil {
+		event.Field = err.Field
+		event.Code = err.Code
+		event.Err = err
+	} else {
+		event.Field = st.fieldPath("")
+	}
+	st.observer.Rule(event)
+}
+
+// stop reports the error of a rule and reports whether the rules of the value must stop,
+// because the error is fatal or the validation is done.
+func (st *state) stop(err *Error) bool {
+	done := st.report(err)
+	if !err.isFatal() {
+		return done
+	}
+	if st.observer != nil {
+		st.observer.Fatal(RuleEvent{Validator: st.validator, Field: err.Field, Code: err.Code, Err: err})
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package validation_test
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+type recordingObserver struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (o *recordingObserver) record(event string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, event)
+}
+
+func (o *recordingObserver) FieldStart(event validation.FieldEvent) {
+	o.record("start " + event.Validator + " " + event.Field)
+}
+
+func (o *recordingObserver) FieldEnd(event validation.FieldEvent) {
+	if event.Duration < 0 {
+		o.record("negative duration")
+	}
+	o.record("end " + event.Validator + " " + event.Field + " " + strconv.Itoa(event.Errors))
+}
+
+func (o *recordingObserver) Rule(event validation.RuleEvent) {
+	if event.Passed() {
+		o.record("pass " + event.Validator + " " + event.Field)
+		return
+	}
+	o.record("fail " + event.Validator + " " + event.Field + " " + event.Code)
+}
+
+func (o *recordingObserver) Fatal(event validation.RuleEvent) {
+	o.record("fatal " + event.Validator + " " + event.Field + " " + event.Code)
+}
+
+func TestObserver(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name },
+			validation.NotZero[string](),
+		),
+		validation.Field("Age", func(u User) int { return u.Age },
+			validation.WithSeverity(validation.SeverityFatal, validation.NumbersMin(18)),
+			validation.NumbersMax(120),
+		),
+		validation.StructField("Address", func(u User) Address { return u.Address },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			).Named("address"),
+		),
+		validation.SliceField("Tags", func(u User) []string { return u.Tags },
+			validation.SlicesMaxLength[string](1),
+		),
+	).Named("user")
+
+	observer := &recordingObserver{}
+	validator.ValidateWithOptions(User{Name: "John", Age: 10, Tags: []string{"a", "b"}}, validation.WithObserver(observer))
+
+	want := []string{
+		"start user Name",
+		"pass user Name",
+		"end user Name 0",
+		"start user Age",
+		"fail user Age min",
+		"fatal user Age min",
+		"end user Age 1",
+		"start user Address",
+		"start address Address.City",
+		"fail address Address.City zero",
+		"end address Address.City 1",
+		"end user Address 1",
+		"start user Tags",
+		"fail user Tags max",
+		"end user Tags 1",
+	}
+	if !reflect.DeepEqual(observer.events, want) {
+		t.Errorf("expected %v, got %v", want, observer.events)
+	}
+}
+
+func TestExpvarObserver(t *testing.T) {
+	observer := validation.NewExpvarObserver("validation_test_failures")
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](2)),
+		),
+		validation.Field("Email", func(b bulk) string { return b.Email },
+			validation.NotZero[string](),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		),
+	).Named("bulk")
+
+	for range 2 {
+		validator.ValidateWithOptions(bulk{Items: make([]Address, 1)}, validation.WithObserver(observer))
+	}
+
+	counters, ok := observer.Failures().Get("bulk").(interface{ String() string })
+	if !ok {
+		t.Fatal("expected counters for bulk")
+	}
+	if got, want := counters.String(), `{"min": 2, "zero": 6}`; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,120 @@
+package validation
+
+import "context"
+
+// Option configures a validation.
+type Option func(*state)
+
+// WithGroups runs only the fields and rules of the given groups.
+// See StructValidator.ValidateGroups.
+func WithGroups(groups ...string) Option {
+	return func(st *state) {
+		st.groups = groups
+	}
+}
+
+// WithFieldMask runs only the fields included by the mask.
+// See StructValidator.ValidatePaths.
+func WithFieldMask(mask FieldMask) Option {
+	return func(st *state) {
+		st.mask = mask
+		if st.mask == nil {
+			st.mask = FieldMask{}
+		}
+	}
+}
+
+// FailFast stops the validation at the first error.
+// It is equivalent to MaxErrors(1).
+func FailFast() Option {
+	return MaxErrors(1)
+}
+
+// MaxErrors stops the validation once n errors have been reported.
+// Warnings and informational diagnostics are not counted.
+// When the validation stops before validating every value, a "truncated" diagnostic
+// is appended to the errors.
+func MaxErrors(n int) Option {
+	return func(st *state) {
+		st.maxErrors = n
+		st.limit = n
+	}
+}
+
+// WithContext stops the validation when the context is canceled,
+// appending a fatal "canceled" error to the errors.
+func WithContext(ctx context.Context) Option {
+	return func(st *state) {
+		st.ctx = ctx
+	}
+}
+
+// Parallel validates the fields of structs, the elements validated by SliceStructField,
+// MapStructField and the Each validator of SliceValidator and MapValidator, and the elements
+// validated by SlicesForEach and MapsForEach rules concurrently,
+// using at most workers goroutines for the whole validation.
+// Errors are reported in the same order as a sequential validation,
+// and entries of MapsForEach in the order of their keys.
+// Rules and getters must be safe for concurrent use.
+func Parallel(workers int) Option {
+	return func(st *state) {
+		if workers > 1 {
+			st.pool = make(chan struct{}, workers-1)
+		}
+	}
+}
+
+// CaptureValues records the rejected value in the Value of every error:
+// the value given to the rule that failed, or the element or entry for rules
+// applied to collections such as SlicesForEach.
+// The "unknown_type", "max_depth", "nil_validator" and field "truncated" errors record the value
+// that was not validated. Errors of the whole validation, such as the "truncated"
+// error of MaxErrors and "canceled", have no value.
+func CaptureValues() Option {
+	return func(st *state) {
+		st.captureValues = true
+	}
+}
+
+// ValidateWithOptions validates the given value with the given options.
+func (v *StructValidator[T]) ValidateWithOptions(value T, opts ...Option) Errors {
+	st := newState(opts...)
+	defer st.release()
+	errs := st.finish(v.validate(st, value))
+	for _, err := range errs {
+		st.repanic(err)
+	}
+	return errs
+}
+
+// ValidateWithOptions validates the given values with the given options.
+func (v *SliceValidator[T]) ValidateWithOptions(values []T, opts ...Option) Errors {
+	st := newState(opts...)
+	defer st.release()
+	errs := st.finish(v.validate(st, values))
+	for _, err := range errs {
+		st.repanic(err)
+	}
+	return errs
+}
+
+// ValidateWithOptions validates the given values with the given options.
+func (v *MapValidator[K, V]) ValidateWithOptions(values map[K]V, opts ...Option) Errors {
+	st := newState(opts...)
+	defer st.release()
+	errs := st.finish(v.validate(st, values))
+	for _, err := range errs {
+		st.repanic(err)
+	}
+	return errs
+}
+
+// truncatedError creates the diagnostic reported when errors are truncated.
+func truncatedError(field string, max int) *Error {
+	return &Error{
+		Field:    field,
+		Code:     "truncated",
+		Params:   map[string]any{"max": max},
+		Severity: SeverityInfo,
+	}
+}
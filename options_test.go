@@ -0,0 +1,510 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jacoelho/validation"
+)
+
+type bulk struct {
+	Name  string
+	Email string
+	Items []Address
+	Codes []string
+}
+
+func errorSummary(errs validation.Errors) []string {
+	var out []string
+	for _, err := range errs {
+		out = append(out, err.Code+":"+err.Field)
+	}
+	return out
+}
+
+func TestValidateWithOptions(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](2)),
+		),
+		validation.Field("Email", func(b bulk) string { return b.Email },
+			validation.NotZero[string](),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		).MaxErrors(2),
+		validation.SliceField("Codes", func(b bulk) []string { return b.Codes },
+			validation.SlicesForEach(validation.NotZero[string]()),
+		),
+	)
+	value := bulk{
+		Items: make([]Address, 5),
+		Codes: make([]string, 3),
+	}
+
+	tests := []struct {
+		name string
+		opts []validation.Option
+		want []string
+	}{
+		{
+			name: "no options applies per field caps",
+			want: []string{
+				"zero:Name", "min:Name", "zero:Email",
+				"zero:Items.0.City", "zero:Items.1.City", "truncated:Items",
+				"zero:Codes.0", "zero:Codes.1", "zero:Codes.2",
+			},
+		},
+		{
+			name: "fail fast",
+			opts: []validation.Option{validation.FailFast()},
+			want: []string{"zero:Name", "truncated:"},
+		},
+		{
+			name: "max errors ignores warnings",
+			opts: []validation.Option{validation.MaxErrors(3)},
+			want: []string{"zero:Name", "min:Name", "zero:Email", "zero:Items.0.City", "truncated:"},
+		},
+		{
+			name: "max errors truncates rule errors",
+			opts: []validation.Option{validation.MaxErrors(6)},
+			want: []string{
+				"zero:Name", "min:Name", "zero:Email",
+				"zero:Items.0.City", "zero:Items.1.City", "truncated:Items",
+				"zero:Codes.0", "zero:Codes.1", "truncated:",
+			},
+		},
+		{
+			name: "global limit below field cap",
+			opts: []validation.Option{validation.MaxErrors(3), validation.WithGroups(validation.DefaultGroup)},
+			want: []string{"zero:Name", "min:Name", "zero:Email", "zero:Items.0.City", "truncated:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorSummary(validator.ValidateWithOptions(value, tt.opts...))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTruncatedDiagnostic(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+		),
+		validation.Field("Email", func(b bulk) string { return b.Email },
+			validation.NotZero[string](),
+		),
+	)
+
+	tests := []struct {
+		name  string
+		value bulk
+		max   int
+		want  []string
+	}{
+		{name: "invalid", value: bulk{}, max: 1, want: []string{"zero:Name", "truncated:"}},
+		{name: "valid", value: bulk{Name: "John", Email: "a@b.c"}, max: 1},
+		{name: "exactly one error", value: bulk{Name: "John"}, max: 1, want: []string{"zero:Email"}},
+		{name: "exactly max errors", value: bulk{}, max: 2, want: []string{"zero:Name", "zero:Email"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateWithOptions(tt.value, validation.MaxErrors(tt.max))
+			if got := errorSummary(errs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			if len(errs) == 0 || errs[len(errs)-1].Code != "truncated" {
+				return
+			}
+			if last := errs[len(errs)-1]; last.Severity != validation.SeverityInfo || last.Params["max"] != tt.max {
+				t.Errorf("unexpected truncated diagnostic: %v", last)
+			}
+		})
+	}
+
+	t.Run("exactly max field errors", func(t *testing.T) {
+		validator := validation.Struct(
+			validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+				validation.Struct(
+					validation.Field("City", func(a Address) string { return a.City },
+						validation.NotZero[string](),
+					),
+				),
+			).MaxErrors(2),
+		)
+		errs := validator.Validate(bulk{Items: make([]Address, 2)})
+		want := []string{"zero:Items.0.City", "zero:Items.1.City"}
+		if got := errorSummary(errs); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+}
+
+func TestParallel(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](2)),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		).MaxErrors(20),
+		validation.SliceField("Codes", func(b bulk) []string { return b.Codes },
+			validation.SlicesForEach(validation.NotZero[string]()),
+		),
+	)
+	value := bulk{
+		Items: make([]Address, 50),
+		Codes: make([]string, 3),
+	}
+
+	tests := []struct {
+		name string
+		opts []validation.Option
+	}{
+		{name: "no limit"},
+		{name: "fail fast", opts: []validation.Option{validation.FailFast()}},
+		{name: "max errors", opts: []validation.Option{validation.MaxErrors(5)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := errorSummary(validator.ValidateWithOptions(value, tt.opts...))
+			for _, workers := range []int{2, 4, 16} {
+				opts := append([]validation.Option{validation.Parallel(workers)}, tt.opts...)
+				got := errorSummary(validator.ValidateWithOptions(value, opts...))
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("workers %d: expected %v, got %v", workers, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParallelElements(t *testing.T) {
+	notZero := validation.Struct(validation.Value(validation.NotZero[string]()))
+
+	tests := []struct {
+		name     string
+		validate func(opts ...validation.Option) validation.Errors
+		want     []string
+	}{
+		{
+			name: "slice struct field",
+			validate: func(opts ...validation.Option) validation.Errors {
+				return validation.Struct(
+					validation.SliceStructField("Codes", func(b bulk) []string { return b.Codes }, notZero),
+				).ValidateWithOptions(bulk{Codes: []string{"a", "", "b", ""}}, opts...)
+			},
+			want: []string{"zero:Codes.1", "zero:Codes.3"},
+		},
+		{
+			name: "slice validator",
+			validate: func(opts ...validation.Option) validation.Errors {
+				return validation.Slices[string](validation.SlicesMinLength[string](5)).Each(notZero).
+					ValidateWithOptions([]string{"a", "", "b", ""}, opts...)
+			},
+			want: []string{"min:", "zero:1", "zero:3"},
+		},
+		{
+			name: "map validator",
+			validate: func(opts ...validation.Option) validation.Errors {
+				return validation.Maps[string, string]().Each(notZero).
+					ValidateWithOptions(map[string]string{"a": "", "b": "x", "c": ""}, opts...)
+			},
+			want: []string{"zero:a", "zero:c"},
+		},
+		{
+			name: "slices for each",
+			validate: func(opts ...validation.Option) validation.Errors {
+				return validation.Slices(validation.SlicesForEach(validation.NotZero[string]())).
+					ValidateWithOptions([]string{"a", "", "b", ""}, opts...)
+			},
+			want: []string{"zero:1", "zero:3"},
+		},
+		{
+			name: "slices for each stops at fatal",
+			validate: func(opts ...validation.Option) validation.Errors {
+				return validation.Slices(validation.SlicesForEach(func(value string) *validation.Error {
+					if value == "" {
+						return &validation.Error{Code: "zero", Fatal: true}
+					}
+					return nil
+				})).ValidateWithOptions([]string{"a", "", ""}, opts...)
+			},
+			want: []string{"zero:1"},
+		},
+		{
+			name: "maps for each",
+			validate: func(opts ...validation.Option) validation.Errors {
+				return validation.Maps(validation.MapsForEach(func(_ string, value string) *validation.Error {
+					if value == "" {
+						return &validation.Error{Code: "zero"}
+					}
+					return nil
+				})).ValidateWithOptions(map[string]string{"a": "x", "b": "", "c": "y"}, opts...)
+			},
+			want: []string{"zero:b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, workers := range []int{1, 4} {
+				if got := errorSummary(tt.validate(validation.Parallel(workers))); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("workers %d: expected %v, got %v", workers, tt.want, got)
+				}
+			}
+		})
+	}
+
+	t.Run("for each rules run concurrently", func(t *testing.T) {
+		var started sync.WaitGroup
+		started.Add(2)
+		rule := func(string) *validation.Error {
+			started.Done()
+			started.Wait()
+			return nil
+		}
+		done := make(chan validation.Errors, 1)
+		go func() {
+			done <- validation.Slices(validation.SlicesForEach(rule)).
+				ValidateWithOptions([]string{"a", "b"}, validation.Parallel(2))
+		}()
+		select {
+		case errs := <-done:
+			if len(errs) != 0 {
+				t.Errorf("expected no errors, got %v", errs)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the elements to be validated concurrently")
+		}
+	})
+}
+
+func TestParallelPanic(t *testing.T) {
+	validator := validation.Struct(
+		validation.SliceStructField("Codes", func(b bulk) []string { return b.Codes },
+			validation.Value(func(code string) *validation.Error {
+				if code == "boom" {
+					panic("boom")
+				}
+				return nil
+			}),
+		),
+	)
+
+	for _, workers := range []int{1, 4} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != "boom" {
+					t.Errorf("expected the panic on the calling goroutine, got %v", r)
+				}
+			}()
+			validator.ValidateWithOptions(bulk{Codes: []string{"a", "b", "boom", "c"}}, validation.Parallel(workers))
+			t.Error("expected a panic")
+		})
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		),
+	)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		workers int
+		value   bulk
+		want    []string
+	}{
+		{name: "canceled", ctx: canceled, workers: 1, value: bulk{Items: make([]Address, 10)}, want: []string{"canceled:"}},
+		{name: "canceled parallel", ctx: canceled, workers: 4, value: bulk{Items: make([]Address, 10)}, want: []string{"canceled:"}},
+		{name: "not canceled", ctx: context.Background(), workers: 1, value: bulk{Name: "John"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateWithOptions(tt.value, validation.WithContext(tt.ctx), validation.Parallel(tt.workers))
+			if got := errorSummary(errs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for _, err := range errs {
+				if !err.Fatal || err.Params["error"] != context.Canceled.Error() || !errors.Is(err, context.Canceled) {
+					t.Errorf("unexpected canceled error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCaptureValues(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(b bulk) string { return b.Name },
+			validation.NotZero[string](),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](2)),
+		),
+		validation.SliceStructField("Items", func(b bulk) []Address { return b.Items },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		),
+		validation.SliceField("Codes", func(b bulk) []string { return b.Codes },
+			validation.SlicesForEach(validation.NotZero[string]()),
+		),
+	)
+	value := bulk{Name: "J", Items: make([]Address, 1), Codes: []string{"a", ""}}
+
+	tests := []struct {
+		name string
+		opts []validation.Option
+		want map[string]any
+	}{
+		{
+			name: "captured",
+			opts: []validation.Option{validation.CaptureValues()},
+			want: map[string]any{"Name": "J", "Items.0.City": "", "Codes.1": ""},
+		},
+		{
+			name: "not captured",
+			want: map[string]any{"Name": nil, "Items.0.City": nil, "Codes.1": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateWithOptions(value, tt.opts...)
+			if len(errs) != len(tt.want) {
+				t.Fatalf("expected %d errors, got %v", len(tt.want), errs)
+			}
+			for _, err := range errs {
+				if v, ok := tt.want[err.Field]; !ok || err.Value != v {
+					t.Errorf("%s: expected value %#v, got %#v", err.Field, v, err.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestCaptureValuesNested(t *testing.T) {
+	type config struct {
+		Port *string
+		Tags map[string]int
+	}
+	validator := validation.Struct(
+		validation.Field("Port", func(c config) *string { return c.Port },
+			validation.Required(validation.StringsParse[string](strconv.Atoi, validation.NumbersMax(1024))),
+		),
+		validation.MapField("Tags", func(c config) map[string]int { return c.Tags },
+			validation.MapsKey("a", validation.NumbersMin(1)),
+		),
+	)
+
+	port := "8080"
+	errs := validator.ValidateWithOptions(config{Port: &port, Tags: map[string]int{"a": 0}}, validation.CaptureValues())
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if errs[0].Value != 8080 {
+		t.Errorf("expected the parsed value, got %#v", errs[0].Value)
+	}
+	if errs[1].Field != "Tags.a" || errs[1].Value != 0 {
+		t.Errorf("expected the entry value, got %s=%#v", errs[1].Field, errs[1].Value)
+	}
+}
+
+func TestCaptureValuesDiagnostics(t *testing.T) {
+	type order struct {
+		Payment paymentMethod
+		Address Address
+		Codes   []string
+	}
+
+	tests := []struct {
+		name      string
+		validator *validation.StructValidator[order]
+		value     order
+		code      string
+		want      any
+	}{
+		{
+			name: "unknown type",
+			validator: validation.Struct(
+				validation.UnionField("Payment", func(o order) paymentMethod { return o.Payment }),
+			),
+			value: order{Payment: cash{}},
+			code:  "unknown_type",
+			want:  cash{},
+		},
+		{
+			name: "max depth",
+			validator: validation.Struct(
+				validation.StructField("Address", func(o order) Address { return o.Address },
+					validation.Struct(
+						validation.Field("City", func(a Address) string { return a.City }, validation.NotZero[string]()),
+					),
+				),
+			).MaxDepth(1),
+			value: order{Address: Address{City: "Lisbon"}},
+			code:  "max_depth",
+			want:  Address{City: "Lisbon"},
+		},
+		{
+			name: "truncated field",
+			validator: validation.Struct(
+				validation.SliceField("Codes", func(o order) []string { return o.Codes },
+					validation.SlicesForEach(validation.NotZero[string]()),
+				).MaxErrors(1),
+			),
+			value: order{Codes: []string{"", ""}},
+			code:  "truncated",
+			want:  []string{"", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.validator.ValidateWithOptions(tt.value, validation.CaptureValues()).ByCode(tt.code)
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 %s error, got %v", tt.code, errs)
+			}
+			if !reflect.DeepEqual(errs[0].Value, tt.want) {
+				t.Errorf("expected value %#v, got %#v", tt.want, errs[0].Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package validation
+
+// Required validates that the pointer is not nil and applies the rules to the dereferenced value.
+func Required[T any](rules ...Rule[T]) Rule[*T] {
+	return func(value *T) *Error {
+		if value == nil {
+			return &Error{Code: "required"}
+		}
+		if err := applyRules(*value, rules); err != nil {
+			err.reject(*value)
+			return err
+		}
+		return nil
+	}
+}
+
+// Optional skips validation if the pointer is nil, otherwise applies the rules to the dereferenced value.
+func Optional[T any](rules ...Rule[T]) Rule[*T] {
+	return func(value *T) *Error {
+		if value == nil {
+			return nil
+		}
+		if err := applyRules(*value, rules); err != nil {
+			err.reject(*value)
+			return err
+		}
+		return nil
+	}
+}
+
+// WithCode replaces the code of the error returned by the rule.
+func WithCode[T any](code string, rule Rule[T]) Rule[T] {
+	return func(value T) *Error {
+		if err := rule(value); err != nil {
+			err.Code = code
+			return err
+		}
+		return nil
+	}
+}
+
+// PtrStructField creates a new FieldAccessor for a pointer to a struct.
+// The rules are applied to the pointer, e.g. Required to reject nil.
+// The validator is only applied when the pointer is not nil, and at most once
+// per path for pointers that refer back to a value being validated.
+func PtrStructField[T, F any](name string, getter func(T) *F, validator *StructValidator[F], rules ...Rule[*F]) FieldAccessor[T, *F] {
+	return FieldAccessor[T, *F]{
+		name:  name,
+		get:   getter,
+		rules: rules,
+		inner: ptrValidator[F]{validator: validator},
+	}
+}
+
+// ptrValidator applies a StructValidator to a non-nil pointer.
+type ptrValidator[T any] struct {
+	validator *StructValidator[T]
+}
+
+// ValidateWithPrefix validates the pointed value with a prefix.
+func (v ptrValidator[T]) ValidateWithPrefix(value *T, prefix string) Errors {
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return v.validate(st, value)
+}
+
+// validate skips nil pointers and pointers already being validated,
+// so cyclic pointer graphs are validated once per path.
+func (v ptrValidator[T]) validate(st *state, value *T) Errors {
+	if value == nil || !st.enter(value) {
+		return nil
+	}
+	defer st.leave(value)
+	return v.validator.validate(st, *value)
+}
+
+// hasFields reports whether the pointed value has fields, which is always the case.
+func (v ptrValidator[T]) hasFields() bool {
+	return true
+}
+
+// validateFields validates the fields of the pointed value, like validate.
+func (v ptrValidator[T]) validateFields(st *state, value *T) Errors {
+	return v.validate(st, value)
+}
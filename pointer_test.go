@@ -0,0 +1,127 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestRequired(t *testing.T) {
+	rule := validation.Required(validation.StringsRuneMinLength[string](2))
+
+	tests := []struct {
+		name    string
+		value   *string
+		errCode string
+	}{
+		{name: "nil should fail", value: nil, errCode: "required"},
+		{name: "invalid value should fail", value: ptr("a"), errCode: "min"},
+		{name: "valid value should pass", value: ptr("ab")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule(tt.value)
+			if tt.errCode == "" {
+				if err != nil {
+					t.Errorf("expected no error but got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Code != tt.errCode {
+				t.Errorf("expected error code %q, got %v", tt.errCode, err)
+			}
+		})
+	}
+}
+
+func TestOptional(t *testing.T) {
+	rule := validation.Optional(validation.NumbersMin(18))
+
+	if err := rule(nil); err != nil {
+		t.Errorf("expected nil to be skipped, got %v", err)
+	}
+	if err := rule(ptr(20)); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := rule(ptr(10)); err == nil || err.Code != "min" {
+		t.Errorf("expected min error, got %v", err)
+	}
+}
+
+func TestWithCode(t *testing.T) {
+	rule := validation.WithCode("missing", validation.Required[string]())
+
+	if err := rule(nil); err == nil || err.Code != "missing" {
+		t.Errorf("expected missing error, got %v", err)
+	}
+	if err := rule(ptr("")); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestPtrStructField(t *testing.T) {
+	type Order struct {
+		Shipping *Address
+		Billing  *Address
+		Note     *string
+	}
+
+	address := validation.Struct(
+		validation.Field("City", func(a Address) string { return a.City },
+			validation.NotZero[string](),
+		),
+	)
+
+	validator := validation.Struct(
+		validation.PtrStructField("Shipping", func(o Order) *Address { return o.Shipping }, address,
+			validation.Required[Address](),
+		),
+		validation.PtrStructField("Billing", func(o Order) *Address { return o.Billing }, address),
+		validation.Field("Note", func(o Order) *string { return o.Note },
+			validation.Optional(validation.StringsRuneMaxLength[string](5)),
+		),
+	)
+
+	tests := []struct {
+		name   string
+		order  Order
+		fields []string
+		codes  []string
+	}{
+		{
+			name:  "valid with optional fields missing",
+			order: Order{Shipping: &Address{City: "Lisbon"}},
+		},
+		{
+			name:   "required pointer missing",
+			order:  Order{},
+			fields: []string{"Shipping"},
+			codes:  []string{"required"},
+		},
+		{
+			name:   "nested errors on present pointers",
+			order:  Order{Shipping: &Address{}, Billing: &Address{}, Note: ptr("too long")},
+			fields: []string{"Shipping.City", "Billing.City", "Note"},
+			codes:  []string{"zero", "zero", "max"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.Validate(tt.order)
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("expected %d errors, got %v", len(tt.fields), errs)
+			}
+			for i, err := range errs {
+				if err.Field != tt.fields[i] || err.Code != tt.codes[i] {
+					t.Errorf("error %d: expected %s (field %s), got %s (field %s)", i, tt.codes[i], tt.fields[i], err.Code, err.Field)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ByField returns the errors of the field and of its nested fields,
+// e.g. "Address" matches "Address" and "Address.City" but not "AddressLine".
+func (errs Errors) ByField(prefix string) Errors {
+	var out Errors
+	for _, e := range errs {
+		if hasFieldPrefix(e.Field, prefix) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// ByCode returns the errors with any of the given codes.
+func (errs Errors) ByCode(codes ...string) Errors {
+	var out Errors
+	for _, e := range errs {
+		if slices.Contains(codes, e.Code) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// GroupByField groups the errors by field path, keeping their order.
+func (errs Errors) GroupByField() map[string]Errors {
+	out := make(map[string]Errors)
+	for _, e := range errs {
+		out[e.Field] = append(out[e.Field], e)
+	}
+	return out
+}
+
+// Messages formats the errors with f grouped by field path, as expected by form libraries.
+func (errs Errors) Messages(f func(e *Error) string) map[string][]string {
+	out := make(map[string][]string)
+	for _, e := range errs {
+		out[e.Field] = append(out[e.Field], f(e))
+	}
+	return out
+}
+
+// ErrorTree is a tree of errors keyed by the segments of their field paths.
+type ErrorTree struct {
+	Errors   Errors
+	Children map[string]*ErrorTree
+}
+
+// Tree builds a tree of the errors keyed by the segments of their field paths.
+// Errors without a field are placed at the root.
+func (errs Errors) Tree() *ErrorTree {
+	root := &ErrorTree{}
+	for _, e := range errs {
+		node := root
+		if e.Field != "" {
+			for segment := range strings.SplitSeq(e.Field, ".") {
+				if node.Children == nil {
+					node.Children = make(map[string]*ErrorTree)
+				}
+				child, ok := node.Children[segment]
+				if !ok {
+					child = &ErrorTree{}
+					node.Children[segment] = child
+				}
+				node = child
+			}
+		}
+		node.Errors = append(node.Errors, e)
+	}
+	return root
+}
+
+// WithPrefix returns copies of the errors with the prefix joined to their field paths.
+func (errs Errors) WithPrefix(prefix string) Errors {
+	if len(errs) == 0 {
+		return nil
+	}
+	out := make(Errors, len(errs))
+	for i, e := range errs {
+		prefixed := *e
+		prefixed.Field = joinField(prefix, e.Field)
+		out[i] = &prefixed
+	}
+	return out
+}
+
+// MergeErrors concatenates the errors of several validations.
+func MergeErrors(errs ...Errors) Errors {
+	return slices.Concat(errs...)
+}
+
+// Dedupe returns the errors without duplicates, keeping the first occurrence.
+// Errors are duplicates when they have the same field, code, severity, params and cause.
+// Errors are compared by their values, before any redaction.
+func (errs Errors) Dedupe() Errors {
+	type key struct {
+		field    string
+		code     string
+		severity Severity
+		params   string
+		cause    string
+	}
+	seen := make(map[key]struct{}, len(errs))
+	var out Errors
+	for _, e := range errs {
+		k := key{field: e.Field, code: e.Code, severity: e.Level(), params: formatParams(e.Params)}
+		if e.Cause != nil {
+			k.cause = e.Cause.Error()
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, e)
+	}
+	return out
+}
+
+// formatParams formats the params sorted by key, without redaction.
+func formatParams(params map[string]any) string {
+	var sb strings.Builder
+	for _, k := range sortedParams(params) {
+		fmt.Fprintf(&sb, "%q:%#v;", k, params[k])
+	}
+	return sb.String()
+}
+
+// Sort sorts the errors in place by field path and then by code.
+// Path segments are compared as numbers when both are slice indexes,
+// so "Items.2" sorts before "Items.10". Errors with the same path and code keep their order.
+func (errs Errors) Sort() {
+	slices.SortStableFunc(errs, func(a, b *Error) int {
+		if c := comparePaths(a.Field, b.Field); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Code, b.Code)
+	})
+}
+
+// comparePaths compares field paths segment by segment.
+func comparePaths(a, b string) int {
+	for a != "" && b != "" {
+		var sa, sb string
+		sa, a, _ = strings.Cut(a, ".")
+		sb, b, _ = strings.Cut(b, ".")
+		if c := compareSegments(sa, sb); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// compareSegments compares path segments, numerically when both are indexes.
+func compareSegments(a, b string) int {
+	ia, errA := strconv.Atoi(a)
+	ib, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return cmp.Compare(ia, ib)
+	}
+	return strings.Compare(a, b)
+}
+
+// hasFieldPrefix reports whether the field is the prefix or one of its nested fields.
+func hasFieldPrefix(field, prefix string) bool {
+	if prefix == "" {
+		return true
+	}
+	rest, ok := strings.CutPrefix(field, prefix)
+	return ok && (rest == "" || rest[0] == '.')
+}
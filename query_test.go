@@ -0,0 +1,160 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func queryErrors() validation.Errors {
+	return validation.Errors{
+		{Field: "Items.10.Name", Code: "required"},
+		{Field: "Address", Code: "required"},
+		{Field: "Items.2.Name", Code: "required"},
+		{Field: "Address.City", Code: "min", Params: map[string]any{"min": 2}},
+		{Field: "AddressLine", Code: "max"},
+		{Field: "Address.City", Code: "alpha"},
+		{Code: "struct"},
+	}
+}
+
+func TestErrorsFilter(t *testing.T) {
+	errs := queryErrors()
+
+	tests := []struct {
+		name string
+		got  validation.Errors
+		want []string
+	}{
+		{
+			name: "by field",
+			got:  errs.ByField("Address"),
+			want: []string{"required:Address", "min:Address.City", "alpha:Address.City"},
+		},
+		{
+			name: "by nested field",
+			got:  errs.ByField("Address.City"),
+			want: []string{"min:Address.City", "alpha:Address.City"},
+		},
+		{
+			name: "by empty field",
+			got:  errs.ByField(""),
+			want: errorSummary(errs),
+		},
+		{
+			name: "by code",
+			got:  errs.ByCode("max", "alpha"),
+			want: []string{"max:AddressLine", "alpha:Address.City"},
+		},
+		{
+			name: "by missing code",
+			got:  errs.ByCode("email"),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorSummary(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestErrorsGroup(t *testing.T) {
+	errs := queryErrors()
+
+	groups := errs.GroupByField()
+	if len(groups) != 6 {
+		t.Errorf("expected 6 groups, got %d", len(groups))
+	}
+	if got, want := errorSummary(groups["Address.City"]), []string{"min:Address.City", "alpha:Address.City"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	messages := errs.Messages(func(e *validation.Error) string { return e.Code })
+	want := map[string][]string{
+		"Items.10.Name": {"required"},
+		"Items.2.Name":  {"required"},
+		"Address":       {"required"},
+		"Address.City":  {"min", "alpha"},
+		"AddressLine":   {"max"},
+		"":              {"struct"},
+	}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("expected %v, got %v", want, messages)
+	}
+}
+
+func TestErrorsTree(t *testing.T) {
+	tree := queryErrors().Tree()
+
+	if got := errorSummary(tree.Errors); !reflect.DeepEqual(got, []string{"struct:"}) {
+		t.Errorf("unexpected root errors %v", got)
+	}
+	address := tree.Children["Address"]
+	if got := errorSummary(address.Errors); !reflect.DeepEqual(got, []string{"required:Address"}) {
+		t.Errorf("unexpected Address errors %v", got)
+	}
+	if got := errorSummary(address.Children["City"].Errors); !reflect.DeepEqual(got, []string{"min:Address.City", "alpha:Address.City"}) {
+		t.Errorf("unexpected Address.City errors %v", got)
+	}
+	items := tree.Children["Items"]
+	if items.Errors != nil || len(items.Children) != 2 {
+		t.Errorf("unexpected Items node %+v", items)
+	}
+	if got := errorSummary(items.Children["10"].Children["Name"].Errors); !reflect.DeepEqual(got, []string{"required:Items.10.Name"}) {
+		t.Errorf("unexpected Items.10.Name errors %v", got)
+	}
+}
+
+func TestErrorsMerge(t *testing.T) {
+	user := validation.Errors{{Field: "Name", Code: "required"}}
+	address := validation.Errors{{Code: "struct"}, {Field: "City", Code: "required"}}
+
+	merged := validation.MergeErrors(user, address.WithPrefix("Address"), nil)
+	want := []string{"required:Name", "struct:Address", "required:Address.City"}
+	if got := errorSummary(merged); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if address[1].Field != "City" {
+		t.Errorf("expected original errors unchanged, got %v", address[1])
+	}
+}
+
+func TestErrorsDedupe(t *testing.T) {
+	errs := validation.Errors{
+		{Field: "Name", Code: "min", Params: map[string]any{"min": 2}},
+		{Field: "Name", Code: "min", Params: map[string]any{"min": 2}},
+		{Field: "Name", Code: "min", Params: map[string]any{"min": 3}},
+		{Field: "Name", Code: "min", Params: map[string]any{"min": 2}, Severity: validation.SeverityWarning},
+		{Field: "Email", Code: "min", Params: map[string]any{"min": 2}},
+		{Field: "PIN", Code: "not_one_of", Params: map[string]any{"value": "1234"}, Sensitive: true},
+		{Field: "PIN", Code: "not_one_of", Params: map[string]any{"value": "0000"}, Sensitive: true},
+	}
+
+	got := errs.Dedupe()
+	if len(got) != 6 || got[0] != errs[0] || got[1] != errs[2] || got[5] != errs[6] {
+		t.Errorf("unexpected deduplicated errors %v", got)
+	}
+}
+
+func TestErrorsSort(t *testing.T) {
+	errs := queryErrors()
+	errs.Sort()
+
+	want := []string{
+		"struct:",
+		"required:Address",
+		"alpha:Address.City",
+		"min:Address.City",
+		"max:AddressLine",
+		"required:Items.2.Name",
+		"required:Items.10.Name",
+	}
+	if got := errorSummary(errs); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package validation_test
+
+// raceEnabled reports whether the race detector is enabled,
+// which makes sync.Pool drop values and allocate.
+const raceEnabled = true
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicMode sets how SafeMode handles panics of rules, getters and nested validators.
+type PanicMode int
+
+const (
+	// PanicRecover converts the panic into an "internal" fatal error.
+	PanicRecover PanicMode = iota + 1
+	// PanicRepanic converts the panic into an "internal" fatal error like PanicRecover,
+	// then panics with that error on the goroutine that called the validation,
+	// also when the panic happened during a parallel validation.
+	PanicRepanic
+)
+
+// SafeMode recovers panics of rules, getters and nested validators.
+// A panic is reported as an "internal" fatal error at the path of the value,
+// with a PanicError as cause.
+// Without SafeMode panics are not recovered.
+func SafeMode(mode PanicMode) Option {
+	return func(st *state) {
+		st.panics = mode
+	}
+}
+
+// PanicError is the cause of the "internal" errors of panics recovered by SafeMode.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error implements error.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// Unwrap returns the panic value when it is an error.
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+// internalError creates the error of a recovered panic.
+func internalError(value any) *Error {
+	return &Error{
+		Code:  "internal",
+		Fatal: true,
+		Cause: &PanicError{Value: value, Stack: debug.Stack()},
+	}
+}
+
+// callRule applies the rule to the value, recovering a panic in safe mode.
+func callRule[F any](st *state, rule Rule[F], value F) (err *Error) {
+	if st.panics != 0 {
+		defer st.recoverRule(&err)
+	}
+	return rule(value)
+}
+
+// callRuleAll applies a rule reporting several errors to the value, recovering a panic in safe mode.
+func callRuleAll[V any](st *state, rule func(V) Errors, value V) (errs Errors) {
+	if st.panics != 0 {
+		defer st.recoverRuleAll(&errs)
+	}
+	return rule(value)
+}
+
+// recoverRule replaces the error of a rule with the error of the recovered panic, if any.
+func (st *state) recoverRule(err **Error) {
+	if r := recover(); r != nil {
+		*err = internalError(r)
+	}
+}
+
+// recoverRuleAll replaces the errors of a rule with the error of the recovered panic, if any.
+func (st *state) recoverRuleAll(errs *Errors) {
+	if r := recover(); r != nil {
+		*errs = Errors{internalError(r)}
+	}
+}
+
+// recoverField replaces the errors of the named field with the error of the recovered panic, if any,
+// and reports it. The path of the value is restored by the time the panic is recovered.
+func (st *state) recoverField(errs *Errors, name string, sensitive bool) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	err := internalError(r)
+	err.Field = st.fieldPath(name)
+	err.Sensitive = sensitive || st.sensitive
+	st.stop(err)
+	*errs = Errors{err}
+}
+
+// repanic panics with the error when it is a recovered panic and the mode is PanicRepanic.
+func (st *state) repanic(err *Error) {
+	var p *PanicError
+	if st.panics == PanicRepanic && errors.As(err.Cause, &p) {
+		panic(err)
+	}
+}
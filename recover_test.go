@@ -0,0 +1,200 @@
+package validation_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func panicRule(value string) *validation.Error {
+	if value == "boom" {
+		panic("rule failed")
+	}
+	return nil
+}
+
+func TestSafeMode(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name },
+			validation.NotZero[string](),
+			panicRule,
+		),
+		validation.Field("Email", func(u User) string { return u.Email },
+			validation.NotZero[string](),
+		),
+		validation.Field("Age", func(u User) int {
+			if u.Age < 0 {
+				panic(io.ErrUnexpectedEOF)
+			}
+			return u.Age
+		}),
+		validation.StructField("Address", func(u User) Address { return u.Address },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City }, panicRule),
+			),
+		),
+		validation.SliceField("Tags", func(u User) []string { return u.Tags },
+			validation.SlicesForEach(panicRule),
+		),
+	)
+
+	tests := []struct {
+		name  string
+		value User
+		opts  []validation.Option
+		want  []string
+	}{
+		{name: "rule", value: User{Name: "boom"}, want: []string{"internal:Name", "zero:Email"}},
+		{name: "getter", value: User{Name: "John", Age: -1}, want: []string{"zero:Email", "internal:Age"}},
+		{
+			name:  "nested validator",
+			value: User{Name: "John", Email: "a@b.c", Address: Address{City: "boom"}},
+			want:  []string{"internal:Address.City"},
+		},
+		{
+			name:  "parallel",
+			value: User{Name: "John", Email: "a@b.c", Tags: []string{"a", "boom"}},
+			opts:  []validation.Option{validation.Parallel(4)},
+			want:  []string{"internal:Tags"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]validation.Option{validation.SafeMode(validation.PanicRecover)}, tt.opts...)
+			errs := validator.ValidateWithOptions(tt.value, opts...)
+			if got := errorSummary(errs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for _, err := range errs.ByCode("internal") {
+				var p *validation.PanicError
+				if !err.Fatal || !errors.As(err, &p) {
+					t.Errorf("expected a fatal error caused by the panic, got %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestPanicError(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name }, panicRule),
+		validation.Field("Age", func(u User) int {
+			if u.Age < 0 {
+				panic(io.ErrUnexpectedEOF)
+			}
+			return u.Age
+		}),
+	)
+
+	tests := []struct {
+		name    string
+		value   User
+		message string
+		stack   string
+		is      error
+	}{
+		{
+			name:    "rule",
+			value:   User{Name: "boom"},
+			message: "internal (field: Name): panic: rule failed",
+			stack:   "panicRule",
+		},
+		{
+			name:    "getter",
+			value:   User{Age: -1},
+			message: "internal (field: Age): panic: unexpected EOF",
+			stack:   "TestPanicError",
+			is:      io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.ValidateWithOptions(tt.value, validation.SafeMode(validation.PanicRecover))
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %v", errs)
+			}
+			var p *validation.PanicError
+			if !errors.As(errs[0], &p) || !strings.Contains(string(p.Stack), tt.stack) {
+				t.Fatalf("expected the panic as cause, got %#v", errs[0].Cause)
+			}
+			if got := errs[0].Error(); got != tt.message {
+				t.Errorf("expected %q, got %q", tt.message, got)
+			}
+			if tt.is != nil && !errors.Is(errs[0], tt.is) {
+				t.Errorf("expected the panic error to be wrapped, got %v", errs[0].Cause)
+			}
+		})
+	}
+}
+
+func TestSafeModeRepanic(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name }, panicRule),
+		validation.StructField("Address", func(u User) Address { return u.Address },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City }, panicRule),
+			),
+		),
+	)
+	repanic := validation.SafeMode(validation.PanicRepanic)
+
+	tests := []struct {
+		name     string
+		validate func()
+		field    string
+	}{
+		{
+			name:     "sequential",
+			validate: func() { validator.ValidateWithOptions(User{Address: Address{City: "boom"}}, repanic) },
+			field:    "Address.City",
+		},
+		{
+			name: "parallel",
+			validate: func() {
+				validator.ValidateWithOptions(User{Address: Address{City: "boom"}}, repanic, validation.Parallel(4))
+			},
+			field: "Address.City",
+		},
+		{
+			name: "sequence",
+			validate: func() {
+				for range validator.ValidateSeq(User{Name: "boom"}, repanic) {
+				}
+			},
+			field: "Name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(*validation.Error)
+				if !ok || err.Code != "internal" || err.Field != tt.field {
+					t.Errorf("expected a panic with the internal error at %s, got %v", tt.field, err)
+				}
+			}()
+			tt.validate()
+			t.Error("expected a panic")
+		})
+	}
+}
+
+func TestWithoutSafeMode(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name }, panicRule),
+	)
+
+	defer func() {
+		if r := recover(); r != "rule failed" {
+			t.Errorf("expected the original panic, got %v", r)
+		}
+	}()
+	validator.Validate(User{Name: "boom"})
+	t.Error("expected a panic")
+}
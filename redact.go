@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+)
+
+// RedactionPolicy reports whether a param of the error is redacted,
+// see Error.Redacted, Errors.Redacted and LogOptions.
+// Redacted params keep their key, with Redacted as value.
+type RedactionPolicy func(e *Error, param string) bool
+
+// RedactSensitive redacts every param of the errors of sensitive fields.
+// It is the policy applied by Error, Errors.Format, JSON encoding, slog and traces.
+func RedactSensitive(e *Error, _ string) bool {
+	return e.Sensitive
+}
+
+// RedactParams returns a policy redacting the given params of every error,
+// and every param of the errors of sensitive fields.
+func RedactParams(params ...string) RedactionPolicy {
+	return func(e *Error, param string) bool {
+		return e.Sensitive || slices.Contains(params, param)
+	}
+}
+
+// Redacted returns the error with its params redacted by the policy, or by RedactSensitive
+// when the policy is nil, and the cause of sensitive errors replaced with Redacted.
+// The captured value is redacted as the "value" param.
+// The error is returned as is when nothing is redacted, otherwise a copy is returned.
+func (e *Error) Redacted(policy RedactionPolicy) *Error {
+	if policy == nil {
+		policy = RedactSensitive
+	}
+	redacted := *e
+	changed := false
+	for k := range e.Params {
+		if !policy(e, k) {
+			continue
+		}
+		if !changed {
+			redacted.Params = maps.Clone(e.Params)
+			changed = true
+		}
+		redacted.Params[k] = Redacted
+	}
+	if e.Value != nil && policy(e, "value") {
+		redacted.Value = Redacted
+		changed = true
+	}
+	if e.Sensitive && e.Cause != nil {
+		redacted.Cause = errRedacted
+		changed = true
+	}
+	if !changed {
+		return e
+	}
+	return &redacted
+}
+
+// Redacted returns the errors with their params redacted by the policy, like Error.Redacted.
+// Redacted params stay redacted once the errors are formatted, encoded to JSON or logged,
+// so the policy applies to a single call, e.g. json.Marshal(errs.Redacted(RedactParams("value"))).
+func (errs Errors) Redacted(policy RedactionPolicy) Errors {
+	if errs == nil {
+		return nil
+	}
+	redacted := make(Errors, len(errs))
+	for i, e := range errs {
+		redacted[i] = e.Redacted(policy)
+	}
+	return redacted
+}
+
+// errRedacted replaces the cause of sensitive errors.
+var errRedacted = errors.New(Redacted)
+
+// plainError is an Error without methods, encoded with the default JSON encoding.
+type plainError Error
+
+// MarshalJSON implements json.Marshaler, redacting the params with RedactSensitive.
+// Fatal and Severity are encoded from Level, so they always agree.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	plain := plainError(*e.Redacted(RedactSensitive))
+	plain.Severity = e.Level()
+	plain.Fatal = e.isFatal()
+	return json.Marshal(&plain)
+}
@@ -0,0 +1,250 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+type credentials struct {
+	Username string
+	Password string
+	PIN      string
+}
+
+func TestSensitive(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Username", func(c credentials) string { return c.Username },
+			validation.NotOneOf("admin", "root"),
+		),
+		validation.Field("Password", func(c credentials) string { return c.Password },
+			validation.NotOneOf("password", "123456"),
+		).Sensitive(),
+		validation.Field("PIN", func(c credentials) string { return c.PIN },
+			validation.StringsParse[string](strconv.Atoi),
+		).Sensitive(),
+	)
+
+	errs := validator.Validate(credentials{Username: "admin", Password: "password", PIN: "12a4"})
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	if errs[0].Sensitive || !errs[1].Sensitive || !errs[2].Sensitive {
+		t.Errorf("unexpected sensitive marks %v", errs)
+	}
+	if errs[1].Params["value"] != "password" {
+		t.Errorf("expected params to keep the value, got %v", errs[1].Params)
+	}
+
+	tests := []struct {
+		name   string
+		format func() string
+		want   string
+	}{
+		{
+			name:   "error",
+			format: errs.Error,
+			want: "not_one_of (field: Username) {value: admin}; " +
+				"not_one_of (field: Password) {value: [REDACTED]}; " +
+				"parse (field: PIN): [REDACTED]",
+		},
+		{
+			name: "format",
+			format: func() string {
+				return errs.Format(func(e *validation.Error) string {
+					return e.Field + "=" + validation.Errors{e}.Error()
+				}, "|")
+			},
+			want: "Username=not_one_of (field: Username) {value: admin}|" +
+				"Password=not_one_of (field: Password) {value: [REDACTED]}|" +
+				"PIN=parse (field: PIN): [REDACTED]",
+		},
+		{
+			name: "json",
+			format: func() string {
+				data, err := json.Marshal(errs[1])
+				if err != nil {
+					t.Fatal(err)
+				}
+				return string(data)
+			},
+			want: `{"Field":"Password","Code":"not_one_of","Params":{"value":"[REDACTED]"},"Fatal":false}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.format(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("log", func(t *testing.T) {
+		if got := logLine(t, errs[1]); strings.Contains(got, "password") || !strings.Contains(got, "params.value=[REDACTED]") {
+			t.Errorf("expected redacted log, got %s", got)
+		}
+	})
+}
+
+func TestSensitiveNested(t *testing.T) {
+	type account struct {
+		Credentials credentials
+	}
+	validator := validation.Struct(
+		validation.StructField("Credentials", func(a account) credentials { return a.Credentials },
+			validation.Struct(
+				validation.Field("Username", func(c credentials) string { return c.Username },
+					validation.NotOneOf("admin", "root"),
+				),
+				validation.Field("Password", func(c credentials) string { return c.Password },
+					validation.NotZero[string](),
+				),
+			),
+		).Sensitive(),
+	)
+
+	errs := validator.Validate(account{Credentials: credentials{Username: "root"}})
+	if len(errs) != 2 || !errs[0].Sensitive || !errs[1].Sensitive {
+		t.Errorf("expected nested errors marked as sensitive, got %v", errs)
+	}
+
+	trace := &validation.Trace{}
+	validator.ValidateWithOptions(account{Credentials: credentials{Username: "root"}}, validation.WithTrace(trace))
+	if strings.Contains(trace.String(), "root") {
+		t.Errorf("expected redacted trace, got\n%s", trace)
+	}
+}
+
+func TestRedactionPolicy(t *testing.T) {
+	policy := validation.RedactParams("value")
+	err := &validation.Error{Code: "between", Params: map[string]any{"min": 1, "max": 10, "value": 42}}
+	errs := validation.Errors{err}
+
+	if got, want := errs.Redacted(policy).Error(), "between {max: 10, min: 1, value: [REDACTED]}"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got, want := errs.Error(), "between {max: 10, min: 1, value: 42}"; got != want {
+		t.Errorf("expected the default policy to keep the params, got %q", got)
+	}
+	data, jsonErr := json.Marshal(errs.Redacted(policy))
+	if jsonErr != nil {
+		t.Fatal(jsonErr)
+	}
+	if !strings.Contains(string(data), `"value":"[REDACTED]"`) {
+		t.Errorf("expected redacted JSON, got %s", data)
+	}
+	if err.Params["value"] != 42 {
+		t.Errorf("expected the error unchanged, got %v", err.Params)
+	}
+	if err.Redacted(policy) == err {
+		t.Error("expected a redacted copy")
+	}
+
+	plain := &validation.Error{Code: "zero"}
+	if plain.Redacted(policy) != plain {
+		t.Error("expected the error itself when nothing is redacted")
+	}
+}
+
+func TestCaptureValuesRedacted(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Username", func(c credentials) string { return c.Username },
+			validation.NotOneOf("admin", "root"),
+		),
+		validation.Field("Password", func(c credentials) string { return c.Password },
+			validation.NotOneOf("password", "123456"),
+		).Sensitive(),
+	)
+
+	errs := validator.ValidateWithOptions(credentials{Username: "admin", Password: "password"}, validation.CaptureValues())
+	if len(errs) != 2 || errs[0].Value != "admin" || errs[1].Value != "password" {
+		t.Fatalf("expected captured values, got %v", errs)
+	}
+
+	tests := []struct {
+		name     string
+		format   func() string
+		contains string
+	}{
+		{
+			name: "json",
+			format: func() string {
+				data, err := json.Marshal(errs)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return string(data)
+			},
+			contains: `"Value":"admin"`,
+		},
+		{
+			name:     "log sensitive",
+			format:   func() string { return logLine(t, errs[1]) },
+			contains: ".value=[REDACTED]",
+		},
+		{
+			name:     "log",
+			format:   func() string { return logLine(t, errs[0]) },
+			contains: "value=admin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.format()
+			if strings.Contains(got, "password") || !strings.Contains(got, tt.contains) {
+				t.Errorf("expected %q without the sensitive value, got %s", tt.contains, got)
+			}
+		})
+	}
+}
+
+func TestCaptureValuesSensitiveDiagnostics(t *testing.T) {
+	type secret struct {
+		Token string
+	}
+	type vault struct {
+		Passwords []string
+		Secret    any
+	}
+	validator := validation.Struct(
+		validation.SliceField("Passwords", func(v vault) []string { return v.Passwords },
+			validation.SlicesForEach(validation.StringsRuneMinLength[string](10)),
+		).MaxErrors(1).Sensitive(),
+		validation.UnionField("Secret", func(v vault) any { return v.Secret }).Sensitive(),
+	)
+
+	errs := validator.ValidateWithOptions(vault{Passwords: []string{"hunter2", "pw"}, Secret: secret{Token: "s3cr3t"}},
+		validation.CaptureValues(),
+	)
+
+	tests := []struct {
+		name string
+		code string
+	}{
+		{name: "field truncated", code: "truncated"},
+		{name: "unknown type", code: "unknown_type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := errs.ByCode(tt.code)
+			if len(found) != 1 || !found[0].Sensitive || found[0].Value == nil {
+				t.Fatalf("expected a sensitive %s error with the value, got %v", tt.code, found)
+			}
+			data, err := json.Marshal(found[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, got := range []string{found[0].Error(), string(data), logLine(t, found[0])} {
+				if strings.Contains(got, "hunter2") || strings.Contains(got, "s3cr3t") {
+					t.Errorf("expected the value redacted, got %s", got)
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package validation
 
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
 // Rule is a function that validates a value.
 type Rule[T any] func(value T) *Error
 
@@ -26,6 +32,28 @@ func RuleStopOnError[T any](rule Rule[T]) Rule[T] {
 	}
 }
 
+// WarnOnly reports the errors of the rule as warnings, which do not fail the validation.
+func WarnOnly[T any](rule Rule[T]) Rule[T] {
+	return describe(WithSeverity(SeverityWarning, rule), "WarnOnly")
+}
+
+// InfoOnly reports the errors of the rule as informational diagnostics, which do not fail the validation.
+func InfoOnly[T any](rule Rule[T]) Rule[T] {
+	return describe(WithSeverity(SeverityInfo, rule), "InfoOnly")
+}
+
+// WithSeverity sets the severity of the errors of the rule.
+func WithSeverity[T any](severity Severity, rule Rule[T]) Rule[T] {
+	return func(value T) *Error {
+		if err := rule(value); err != nil {
+			err.Severity = severity
+			err.Fatal = severity == SeverityFatal
+			return err
+		}
+		return nil
+	}
+}
+
 // Or combines multiple rules, at least one must pass.
 // If all rules fail, the last error is returned.
 func Or[T any](rules ...Rule[T]) Rule[T] {
@@ -43,23 +71,28 @@ func Or[T any](rules ...Rule[T]) Rule[T] {
 }
 
 // When applies a rule only if the condition is true.
+// Traces record the rule as skipped when the condition is false.
 func When[T any](condition func(T) bool, rule Rule[T]) Rule[T] {
-	return func(value T) *Error {
-		if condition(value) {
-			return rule(value)
-		}
-		return nil
-	}
+	return conditionalRule(condition, false, rule, "When")
 }
 
-// Unless applies a rule only if the condition is false
+// Unless applies a rule only if the condition is false.
+// Traces record the rule as skipped when the condition is true.
 func Unless[T any](condition func(T) bool, rule Rule[T]) Rule[T] {
-	return func(value T) *Error {
-		if !condition(value) {
+	return conditionalRule(condition, true, rule, "Unless")
+}
+
+// conditionalRule applies the rule only if the condition holds, or does not hold when unless is set,
+// describing the condition to traces.
+func conditionalRule[T any](holds func(T) bool, unless bool, rule Rule[T], name string) Rule[T] {
+	conditional := Rule[T](func(value T) *Error {
+		if holds(value) != unless {
 			return rule(value)
 		}
 		return nil
-	}
+	})
+	describeInfo(conditional, &ruleInfo{name: name, condition: condition[T]{holds: holds, unless: unless, rule: rule}})
+	return conditional
 }
 
 // NotZero ensures the value is not the zero value for its type
@@ -121,3 +154,81 @@ func NotOneOf[T comparable](disallowed ...T) Rule[T] {
 		return nil
 	}
 }
+
+// applyRules applies the rules in order and returns the first error.
+func applyRules[T any](value T, rules []Rule[T]) *Error {
+	for _, rule := range rules {
+		if err := rule(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ErrInvalidRule is returned by rule constructors when the rule configuration is invalid.
+var ErrInvalidRule = errors.New("validation: invalid rule")
+
+// Must returns the rule or panics if err is not nil.
+// It is intended for rules built from static configuration.
+func Must[R any](rule R, err error) R {
+	if err != nil {
+		panic(err)
+	}
+	return rule
+}
+
+// invalidRule returns an ErrInvalidRule error for the named rule.
+func invalidRule(name, format string, args ...any) error {
+	return fmt.Errorf("%w: %s: %s", ErrInvalidRule, name, fmt.Sprintf(format, args...))
+}
+
+// checkLength reports an error if the length is negative.
+func checkLength(name, param string, n int) error {
+	if n < 0 {
+		return invalidRule(name, "%s must not be negative, got %d", param, n)
+	}
+	return nil
+}
+
+// checkRange reports an error if min is greater than max.
+func checkRange[T cmp.Ordered](name string, min, max T) error {
+	if cmp.Compare(min, max) > 0 {
+		return invalidRule(name, "min %v is greater than max %v", min, max)
+	}
+	return nil
+}
+
+// checkLengthRange reports an error if any bound is negative or min is greater than max.
+func checkLengthRange(name string, min, max int) error {
+	if err := checkLength(name, "min", min); err != nil {
+		return err
+	}
+	if err := checkLength(name, "max", max); err != nil {
+		return err
+	}
+	return checkRange(name, min, max)
+}
+
+// checkNotEmpty reports an error if no values are given.
+func checkNotEmpty[T any](name string, values []T) error {
+	if len(values) == 0 {
+		return invalidRule(name, "at least one value is required")
+	}
+	return nil
+}
+
+// OneOfE is like OneOf but returns an error if no allowed values are given.
+func OneOfE[T comparable](allowed ...T) (Rule[T], error) {
+	if err := checkNotEmpty("OneOf", allowed); err != nil {
+		return nil, err
+	}
+	return OneOf(allowed...), nil
+}
+
+// NotOneOfE is like NotOneOf but returns an error if no disallowed values are given.
+func NotOneOfE[T comparable](disallowed ...T) (Rule[T], error) {
+	if err := checkNotEmpty("NotOneOf", disallowed); err != nil {
+		return nil, err
+	}
+	return NotOneOf(disallowed...), nil
+}
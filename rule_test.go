@@ -1,6 +1,8 @@
 package validation_test
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"strings"
 	"testing"
@@ -787,3 +789,117 @@ func TestNotOneOf(t *testing.T) {
 		}
 	})
 }
+
+func TestMust(t *testing.T) {
+	rule := validation.Must(validation.OneOfE("a", "b"))
+	if err := rule("c"); err == nil || err.Code != "one_of" {
+		t.Errorf("expected one_of error, got %v", err)
+	}
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, validation.ErrInvalidRule) {
+			t.Errorf("expected ErrInvalidRule panic, got %v", r)
+		}
+	}()
+	validation.Must(validation.OneOfE[string]())
+}
+
+func TestRuleConstructionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  func() error
+	}{
+		{"OneOf empty", func() error { _, err := validation.OneOfE[int](); return err }},
+		{"NotOneOf empty", func() error { _, err := validation.NotOneOfE[int](); return err }},
+		{"NumbersBetween inverted", func() error { _, err := validation.NumbersBetweenE(10, 1); return err }},
+		{"NumbersBetween NaN", func() error { _, err := validation.NumbersBetweenE(math.NaN(), 1); return err }},
+		{"NumbersMin NaN", func() error { _, err := validation.NumbersMinE(math.NaN()); return err }},
+		{"NumbersMax NaN", func() error { _, err := validation.NumbersMaxE(math.NaN()); return err }},
+		{"StringsRuneMinLength negative", func() error { _, err := validation.StringsRuneMinLengthE[string](-1); return err }},
+		{"StringsRuneMaxLength negative", func() error { _, err := validation.StringsRuneMaxLengthE[string](-1); return err }},
+		{"StringsRuneLengthBetween inverted", func() error { _, err := validation.StringsRuneLengthBetweenE[string](5, 2); return err }},
+		{"StringsMatchesRegex invalid", func() error { _, err := validation.StringsMatchesRegexE[string]("["); return err }},
+		{"SlicesMinLength negative", func() error { _, err := validation.SlicesMinLengthE[int](-1); return err }},
+		{"SlicesMaxLength negative", func() error { _, err := validation.SlicesMaxLengthE[int](-1); return err }},
+		{"SlicesLength negative", func() error { _, err := validation.SlicesLengthE[int](-1); return err }},
+		{"SlicesInBetweenLength negative", func() error { _, err := validation.SlicesInBetweenLengthE[int](-1, 2); return err }},
+		{"SlicesInBetweenLength inverted", func() error { _, err := validation.SlicesInBetweenLengthE[int](3, 2); return err }},
+		{"SlicesAtIndex negative", func() error { _, err := validation.SlicesAtIndexE[int](-1); return err }},
+		{"SlicesOneOf empty", func() error { _, err := validation.SlicesOneOfE[int](); return err }},
+		{"SlicesNotOneOf empty", func() error { _, err := validation.SlicesNotOneOfE[int](); return err }},
+		{"MapsMinKeys negative", func() error { _, err := validation.MapsMinKeysE[string, int](-1); return err }},
+		{"MapsMaxKeys negative", func() error { _, err := validation.MapsMaxKeysE[string, int](-1); return err }},
+		{"MapsLength negative", func() error { _, err := validation.MapsLengthE[string, int](-1); return err }},
+		{"MapsLengthBetween inverted", func() error { _, err := validation.MapsLengthBetweenE[string, int](3, 1); return err }},
+		{"MapsKeysOneOf empty", func() error { _, err := validation.MapsKeysOneOfE[string, int](); return err }},
+		{"MapsKeysNotOneOf empty", func() error { _, err := validation.MapsKeysNotOneOfE[string, int](); return err }},
+		{"MapsValuesOneOf empty", func() error { _, err := validation.MapsValuesOneOfE[string, int](); return err }},
+		{"MapsValuesNotOneOf empty", func() error { _, err := validation.MapsValuesNotOneOfE[string, int](); return err }},
+		{"TimeBetween inverted", func() error {
+			_, err := validation.TimeBetweenE(time.Now(), time.Now().Add(-time.Hour))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.err(); !errors.Is(err, validation.ErrInvalidRule) {
+				t.Errorf("expected ErrInvalidRule, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRuleConstructionValid(t *testing.T) {
+	if _, err := validation.NumbersBetweenE(1, 10); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := validation.StringsMatchesRegexE[string](`^\d+$`); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := validation.SlicesInBetweenLengthE[int](0, 0); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := validation.MapsLengthBetweenE[string, int](1, 1); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWarnOnly(t *testing.T) {
+	type Account struct {
+		Username string
+		Legacy   string
+	}
+
+	validator := validation.Struct(
+		validation.Field("Username", func(a Account) string { return a.Username },
+			validation.NotZero[string](),
+			validation.InfoOnly(validation.RuleNot(validation.StringsContains[string]("admn"))),
+			validation.WarnOnly(validation.StringsRuneMinLength[string](8)),
+		),
+		validation.Field("Legacy", func(a Account) string { return a.Legacy },
+			validation.WarnOnly(validation.RuleStopOnError(validation.RuleNot(validation.NotZero[string]()))),
+		),
+	)
+
+	errs := validator.Validate(Account{Username: "admn", Legacy: "value"})
+	if errs.HasErrors() {
+		t.Fatalf("expected only non-blocking diagnostics, got %v", errs)
+	}
+	want := []validation.Severity{validation.SeverityInfo, validation.SeverityWarning, validation.SeverityWarning}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d diagnostics, got %v", len(want), errs)
+	}
+	for i, severity := range want {
+		if errs[i].Level() != severity {
+			t.Errorf("diagnostic %d: expected %v, got %v", i, severity, errs[i].Level())
+		}
+	}
+
+	errs = validator.Validate(Account{})
+	if !errs.HasErrors() || len(errs.Blocking()) != 1 {
+		t.Errorf("expected a single blocking error, got %v", errs)
+	}
+}
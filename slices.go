@@ -11,6 +11,7 @@ type SliceRule[T any] func(values []T) Errors
 // SliceValidator is a validator for slices of values.
 type SliceValidator[T any] struct {
 	rules []SliceRule[T]
+	each  *StructValidator[T]
 }
 
 // Slices creates a new SliceValidator with the given rules.
@@ -18,35 +19,92 @@ func Slices[T any](rules ...SliceRule[T]) *SliceValidator[T] {
 	return &SliceValidator[T]{rules: rules}
 }
 
+// Each sets the validator applied to every element, as SliceStructField does.
+// It returns the validator to allow chaining.
+func (v *SliceValidator[T]) Each(validator *StructValidator[T]) *SliceValidator[T] {
+	v.each = validator
+	return v
+}
+
 // Validate validates the given values.
 func (v *SliceValidator[T]) Validate(values []T) Errors {
 	return v.ValidateWithPrefix(values, "")
 }
 
+// Check validates the given values and returns the errors as an error,
+// or nil if there are no errors. Warnings and informational diagnostics alone return nil.
+func (v *SliceValidator[T]) Check(values []T) error {
+	return v.Validate(values).Err()
+}
+
 // ValidateWithPrefix validates the given values with a prefix.
 func (v *SliceValidator[T]) ValidateWithPrefix(values []T, prefix string) Errors {
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return v.validate(st, values)
+}
+
+func (v *SliceValidator[T]) validate(st *state, values []T) Errors {
 	var out Errors
-	for _, rule := range v.rules {
-		ruleErrs := rule(values)
-		for _, err := range ruleErrs {
-			if err.Field != "" {
-				err.Field = joinField(prefix, err.Field)
-			} else {
-				err.Field = prefix
-			}
+	for i, rule := range v.rules {
+		errs := evaluateAll(st, parallelSliceRule(st, rule, values), values)
+		for j, err := range errs {
 			out = append(out, err)
-			if err.Fatal {
+			if st.stop(err) {
+				st.skip(j+1 < len(errs) || i+1 < len(v.rules) || v.each != nil && len(values) > 0)
 				return out
 			}
 		}
 	}
+
+	return append(out, v.validateFields(st, values)...)
+}
+
+// hasFields reports whether the elements are validated with a struct validator.
+func (v *SliceValidator[T]) hasFields() bool {
+	return v.each != nil
+}
+
+// validateFields validates each element with the struct validator of the elements, if any.
+func (v *SliceValidator[T]) validateFields(st *state, values []T) Errors {
+	if v.each == nil {
+		return nil
+	}
+	if st.concurrent(len(values)) {
+		return eachParallel(st, v.each, values, validateElement)
+	}
+	var out Errors
+	for i, value := range values {
+		if st.halted() {
+			break
+		}
+		out = append(out, validateElement(st, v.each, value, i)...)
+	}
 	return out
 }
 
+// validateElement validates the element at index i of a slice with the validator.
+func validateElement[T any](st *state, validator *StructValidator[T], value T, i int) Errors {
+	st.pushIndex(i)
+	defer st.pop()
+	if st.trace != nil {
+		st.trace.enter(strconv.Itoa(i))
+		defer st.trace.leave()
+	}
+	return validator.validate(st, value)
+}
+
 // SlicesMinLength validates that the slice has at least the given length.
 func SlicesMinLength[T any](min int) SliceRule[T] {
+	return describe(SlicesMinLengthFunc[T](func() int { return min }), "SlicesMinLength")
+}
+
+// SlicesMinLengthFunc validates that the slice has at least the length returned by fn.
+// The minimum is resolved every time the rule is evaluated.
+func SlicesMinLengthFunc[T any](fn func() int) SliceRule[T] {
 	return func(values []T) Errors {
-		if len(values) < min {
+		if min := fn(); len(values) < min {
 			return SingleErrorSlice("", "min", map[string]any{"min": min, "actual": len(values)}, false)
 		}
 		return nil
@@ -55,8 +113,14 @@ func SlicesMinLength[T any](min int) SliceRule[T] {
 
 // SlicesMaxLength validates that the slice has at most the given length.
 func SlicesMaxLength[T any](max int) SliceRule[T] {
+	return describe(SlicesMaxLengthFunc[T](func() int { return max }), "SlicesMaxLength")
+}
+
+// SlicesMaxLengthFunc validates that the slice has at most the length returned by fn.
+// The maximum is resolved every time the rule is evaluated.
+func SlicesMaxLengthFunc[T any](fn func() int) SliceRule[T] {
 	return func(values []T) Errors {
-		if len(values) > max {
+		if max := fn(); len(values) > max {
 			return SingleErrorSlice("", "max", map[string]any{"max": max, "actual": len(values)}, false)
 		}
 		return nil
@@ -65,7 +129,14 @@ func SlicesMaxLength[T any](max int) SliceRule[T] {
 
 // SlicesInBetweenLength validates that the slice has between the given lengths.
 func SlicesInBetweenLength[T any](min, max int) SliceRule[T] {
+	return describe(SlicesInBetweenLengthFunc[T](func() (int, int) { return min, max }), "SlicesInBetweenLength")
+}
+
+// SlicesInBetweenLengthFunc validates that the slice length is between the bounds returned by fn.
+// The bounds are resolved every time the rule is evaluated.
+func SlicesInBetweenLengthFunc[T any](fn func() (min, max int)) SliceRule[T] {
 	return func(values []T) Errors {
+		min, max := fn()
 		if len(values) < min || len(values) > max {
 			return SingleErrorSlice("", "between", map[string]any{"min": min, "max": max, "actual": len(values)}, false)
 		}
@@ -75,8 +146,14 @@ func SlicesInBetweenLength[T any](min, max int) SliceRule[T] {
 
 // SlicesLength validates that the slice has the given length.
 func SlicesLength[T any](length int) SliceRule[T] {
+	return describe(SlicesLengthFunc[T](func() int { return length }), "SlicesLength")
+}
+
+// SlicesLengthFunc validates that the slice has the length returned by fn.
+// The length is resolved every time the rule is evaluated.
+func SlicesLengthFunc[T any](fn func() int) SliceRule[T] {
 	return func(values []T) Errors {
-		if len(values) != length {
+		if length := fn(); len(values) != length {
 			return SingleErrorSlice("", "length", map[string]any{"length": length, "actual": len(values)}, false)
 		}
 		return nil
@@ -85,20 +162,57 @@ func SlicesLength[T any](length int) SliceRule[T] {
 
 // SlicesForEach validates each value in the slice using the given rules.
 func SlicesForEach[T any](rules ...Rule[T]) SliceRule[T] {
-	return func(values []T) Errors {
+	element := eachElement[T](func(values []T, i int) Errors {
 		var errs Errors
-		for i, v := range values {
-			for _, rule := range rules {
-				if err := rule(v); err != nil {
-					err.Field = strconv.Itoa(i)
-					errs = append(errs, err)
-					if err.Fatal {
-						return errs
-					}
+		v := values[i]
+		for _, rule := range rules {
+			if err := rule(v); err != nil {
+				err.nest(strconv.Itoa(i))
+				err.reject(v)
+				errs = append(errs, err)
+				if err.isFatal() {
+					return errs
 				}
 			}
 		}
 		return errs
+	})
+	forEach := SliceRule[T](func(values []T) Errors {
+		var errs Errors
+		for i := range values {
+			if errs = append(errs, element(values, i)...); stoppedAtFatal(errs) {
+				return errs
+			}
+		}
+		return errs
+	})
+	describeInfo(forEach, &ruleInfo{name: "SlicesForEach", each: element})
+	return forEach
+}
+
+// eachElement validates the element at index i of a slice, for rules created by SlicesForEach.
+type eachElement[T any] func(values []T, i int) Errors
+
+// parallelSliceRule returns a rule validating the elements concurrently when the rule
+// was created by SlicesForEach and the elements are validated concurrently, or otherwise the rule.
+func parallelSliceRule[T any](st *state, rule SliceRule[T], values []T) SliceRule[T] {
+	if !st.concurrent(len(values)) {
+		return rule
+	}
+	info := describedRule(rule)
+	if info == nil {
+		return rule
+	}
+	element, ok := info.each.(eachElement[T])
+	if !ok {
+		return rule
+	}
+	return func(values []T) Errors {
+		results := make([]Errors, len(values))
+		runParallel(st, len(values), func(i int) {
+			results[i] = element(values, i)
+		})
+		return mergeResults(results)
 	}
 }
 
@@ -169,9 +283,10 @@ func SlicesAtIndex[T any](index int, rules ...Rule[T]) SliceRule[T] {
 		for _, rule := range rules {
 			err := rule(v)
 			if err != nil {
-				err.Field = strconv.Itoa(index)
+				err.nest(strconv.Itoa(index))
+				err.reject(v)
 				errs = append(errs, err)
-				if err.Fatal {
+				if err.isFatal() {
 					return errs
 				}
 			}
@@ -179,3 +294,60 @@ func SlicesAtIndex[T any](index int, rules ...Rule[T]) SliceRule[T] {
 		return errs
 	}
 }
+
+// SlicesMinLengthE is like SlicesMinLength but returns an error if the minimum is negative.
+func SlicesMinLengthE[T any](min int) (SliceRule[T], error) {
+	if err := checkLength("SlicesMinLength", "min", min); err != nil {
+		return nil, err
+	}
+	return SlicesMinLength[T](min), nil
+}
+
+// SlicesMaxLengthE is like SlicesMaxLength but returns an error if the maximum is negative.
+func SlicesMaxLengthE[T any](max int) (SliceRule[T], error) {
+	if err := checkLength("SlicesMaxLength", "max", max); err != nil {
+		return nil, err
+	}
+	return SlicesMaxLength[T](max), nil
+}
+
+// SlicesInBetweenLengthE is like SlicesInBetweenLength but returns an error
+// if a bound is negative or min is greater than max.
+func SlicesInBetweenLengthE[T any](min, max int) (SliceRule[T], error) {
+	if err := checkLengthRange("SlicesInBetweenLength", min, max); err != nil {
+		return nil, err
+	}
+	return SlicesInBetweenLength[T](min, max), nil
+}
+
+// SlicesLengthE is like SlicesLength but returns an error if the length is negative.
+func SlicesLengthE[T any](length int) (SliceRule[T], error) {
+	if err := checkLength("SlicesLength", "length", length); err != nil {
+		return nil, err
+	}
+	return SlicesLength[T](length), nil
+}
+
+// SlicesOneOfE is like SlicesOneOf but returns an error if no allowed values are given.
+func SlicesOneOfE[T comparable](allowed ...T) (SliceRule[T], error) {
+	if err := checkNotEmpty("SlicesOneOf", allowed); err != nil {
+		return nil, err
+	}
+	return SlicesOneOf(allowed...), nil
+}
+
+// SlicesNotOneOfE is like SlicesNotOneOf but returns an error if no disallowed values are given.
+func SlicesNotOneOfE[T comparable](disallowed ...T) (SliceRule[T], error) {
+	if err := checkNotEmpty("SlicesNotOneOf", disallowed); err != nil {
+		return nil, err
+	}
+	return SlicesNotOneOf(disallowed...), nil
+}
+
+// SlicesAtIndexE is like SlicesAtIndex but returns an error if the index is negative.
+func SlicesAtIndexE[T any](index int, rules ...Rule[T]) (SliceRule[T], error) {
+	if err := checkLength("SlicesAtIndex", "index", index); err != nil {
+		return nil, err
+	}
+	return SlicesAtIndex(index, rules...), nil
+}
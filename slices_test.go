@@ -763,3 +763,30 @@ func TestSlicesAtIndex(t *testing.T) {
 		}
 	})
 }
+
+func TestSlicesFunc(t *testing.T) {
+	limit := 2
+	values := []int{1, 2, 3}
+
+	rules := map[string]validation.SliceRule[int]{
+		"max":     validation.SlicesMaxLengthFunc[int](func() int { return limit }),
+		"between": validation.SlicesInBetweenLengthFunc[int](func() (int, int) { return 0, limit }),
+		"length":  validation.SlicesLengthFunc[int](func() int { return limit }),
+	}
+	for name, rule := range rules {
+		if errs := rule(values); len(errs) != 1 || errs[0].Code != name {
+			t.Errorf("%s: expected %s error, got %v", name, name, errs)
+		}
+	}
+
+	limit = 3
+	for name, rule := range rules {
+		if errs := rule(values); len(errs) != 0 {
+			t.Errorf("%s: expected no errors after changing limit, got %v", name, errs)
+		}
+	}
+
+	if errs := validation.SlicesMinLengthFunc[int](func() int { return limit + 1 })(values); len(errs) != 1 || errs[0].Code != "min" {
+		t.Errorf("expected min error, got %v", errs)
+	}
+}
@@ -0,0 +1,112 @@
+package validation
+
+import (
+	"log/slog"
+	"strconv"
+)
+
+// Redacted replaces the values of redacted params.
+const Redacted = "[REDACTED]"
+
+// LogOptions configures how errors are logged with log/slog.
+type LogOptions struct {
+	// MaxErrors is the maximum number of errors logged.
+	// The number of errors left out is logged as "omitted". Zero logs all the errors.
+	MaxErrors int
+	// Redaction is the redaction policy of the logged params and values,
+	// e.g. RedactParams("value"). Nil redacts with RedactSensitive.
+	Redaction RedactionPolicy
+}
+
+// redacted reports whether the param of the error is logged as Redacted.
+func (opts LogOptions) redacted(e *Error, param string) bool {
+	if opts.Redaction != nil {
+		return opts.Redaction(e, param)
+	}
+	return RedactSensitive(e, param)
+}
+
+// DefaultLogMaxErrors is the maximum number of errors logged by the LogValue methods
+// of Error and Errors. Use WithLogOptions to log with other options.
+const DefaultLogMaxErrors = 20
+
+// LogValue implements slog.LogValuer, logging the error as a group
+// with its field, code, severity, params, cause and captured value.
+func (e *Error) LogValue() slog.Value {
+	return LogOptions{MaxErrors: DefaultLogMaxErrors}.errorValue(e)
+}
+
+// LogValue implements slog.LogValuer, logging the errors as a group
+// with their count and the errors as groups keyed by their index.
+func (errs Errors) LogValue() slog.Value {
+	return LogOptions{MaxErrors: DefaultLogMaxErrors}.errorsValue(errs)
+}
+
+// WithLogOptions returns a slog.LogValuer logging the errors with the given options.
+func (errs Errors) WithLogOptions(opts LogOptions) slog.LogValuer {
+	return loggedErrors{errs: errs, opts: opts}
+}
+
+// loggedErrors logs errors with the options given to WithLogOptions.
+type loggedErrors struct {
+	errs Errors
+	opts LogOptions
+}
+
+// LogValue implements slog.LogValuer.
+func (l loggedErrors) LogValue() slog.Value {
+	return l.opts.errorsValue(l.errs)
+}
+
+// errorsValue returns the slog value of the errors.
+func (opts LogOptions) errorsValue(errs Errors) slog.Value {
+	logged := errs
+	if opts.MaxErrors > 0 && len(logged) > opts.MaxErrors {
+		logged = logged[:opts.MaxErrors]
+	}
+
+	attrs := make([]slog.Attr, 0, len(logged))
+	for i, e := range logged {
+		attrs = append(attrs, slog.Attr{Key: strconv.Itoa(i), Value: opts.errorValue(e)})
+	}
+
+	group := []slog.Attr{
+		slog.Int("count", len(errs)),
+		slog.Attr{Key: "errors", Value: slog.GroupValue(attrs...)},
+	}
+	if omitted := len(errs) - len(logged); omitted > 0 {
+		group = append(group, slog.Int("omitted", omitted))
+	}
+	return slog.GroupValue(group...)
+}
+
+// errorValue returns the slog value of the error.
+func (opts LogOptions) errorValue(e *Error) slog.Value {
+	attrs := []slog.Attr{
+		slog.String("field", e.Field),
+		slog.String("code", e.Code),
+		slog.String("severity", e.Level().String()),
+	}
+	if len(e.Params) > 0 {
+		params := make([]slog.Attr, 0, len(e.Params))
+		for _, k := range sortedParams(e.Params) {
+			if opts.redacted(e, k) {
+				params = append(params, slog.String(k, Redacted))
+				continue
+			}
+			params = append(params, slog.Any(k, e.Params[k]))
+		}
+		attrs = append(attrs, slog.Attr{Key: "params", Value: slog.GroupValue(params...)})
+	}
+	if e.Cause != nil {
+		attrs = append(attrs, slog.String("cause", e.Redacted(opts.Redaction).Cause.Error()))
+	}
+	if e.Value != nil {
+		if opts.redacted(e, "value") {
+			attrs = append(attrs, slog.String("value", Redacted))
+		} else {
+			attrs = append(attrs, slog.Any("value", e.Value))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,85 @@
+package validation_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func logLine(t *testing.T, value any) string {
+	t.Helper()
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("invalid", "validation", value)
+	return strings.TrimSpace(buf.String())
+}
+
+func TestErrorLogValue(t *testing.T) {
+	err := &validation.Error{
+		Field:    "Age",
+		Code:     "min",
+		Params:   map[string]any{"min": 18, "actual": 10},
+		Severity: validation.SeverityWarning,
+		Cause:    errors.New("boom"),
+	}
+
+	want := `msg=invalid validation.field=Age validation.code=min validation.severity=warning validation.params.actual=10 validation.params.min=18 validation.cause=boom`
+	if got := logLine(t, err); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestErrorsLogValue(t *testing.T) {
+	errs := validation.Errors{
+		{Field: "Name", Code: "required"},
+		{Field: "Password", Code: "min", Params: map[string]any{"min": 8, "value": "secret"}, Fatal: true},
+		{Field: "Email", Code: "email"},
+	}
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{
+			name:  "default options",
+			value: errs,
+			want: `msg=invalid validation.count=3 ` +
+				`validation.errors.0.field=Name validation.errors.0.code=required validation.errors.0.severity=error ` +
+				`validation.errors.1.field=Password validation.errors.1.code=min validation.errors.1.severity=fatal validation.errors.1.params.min=8 validation.errors.1.params.value=secret ` +
+				`validation.errors.2.field=Email validation.errors.2.code=email validation.errors.2.severity=error`,
+		},
+		{
+			name:  "capped and redacted",
+			value: errs.WithLogOptions(validation.LogOptions{MaxErrors: 2, Redaction: validation.RedactParams("value")}),
+			want: `msg=invalid validation.count=3 ` +
+				`validation.errors.0.field=Name validation.errors.0.code=required validation.errors.0.severity=error ` +
+				`validation.errors.1.field=Password validation.errors.1.code=min validation.errors.1.severity=fatal validation.errors.1.params.min=8 validation.errors.1.params.value=[REDACTED] ` +
+				`validation.omitted=1`,
+		},
+		{
+			name:  "no errors",
+			value: validation.Errors(nil),
+			want:  `msg=invalid validation.count=0`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logLine(t, tt.value); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,387 @@
+package validation
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultMaxDepth is the maximum nesting depth of struct validators
+// when no limit is configured with StructValidator.MaxDepth.
+const DefaultMaxDepth = 64
+
+// state is shared by nested validators during a single validation.
+type state struct {
+	depth    int
+	maxDepth int
+	visiting map[any]struct{}
+	groups   []string
+	mask     FieldMask
+
+	prefix string
+	path   []segment
+
+	errors    int
+	limit     int
+	maxErrors int
+	skipped   bool
+
+	ctx  context.Context
+	pool chan struct{}
+
+	yield   func(*Error) bool
+	stopped bool
+
+	observer  Observer
+	validator string
+	trace     *Trace
+	sensitive bool
+
+	captureValues bool
+	panics        PanicMode
+}
+
+// segment is an element of the path of the value being validated,
+// either a field name or, when index is not negative, a slice index.
+type segment struct {
+	name  string
+	index int
+}
+
+var statePool = sync.Pool{
+	New: func() any { return new(state) },
+}
+
+// newState creates the state for a new validation.
+// States are pooled, so the validation must release it once done.
+func newState(opts ...Option) *state {
+	st := statePool.Get().(*state)
+	*st = state{
+		maxDepth: DefaultMaxDepth,
+		visiting: st.visiting,
+		path:     st.path[:0],
+	}
+	for _, opt := range opts {
+		opt(st)
+	}
+	if st.trace != nil {
+		st.pool = nil
+	}
+	return st
+}
+
+// release returns the state to the pool.
+func (st *state) release() {
+	clear(st.visiting)
+	st.ctx = nil
+	st.yield = nil
+	st.observer = nil
+	st.trace = nil
+	statePool.Put(st)
+}
+
+// push appends a field name to the path of the value being validated.
+func (st *state) push(name string) {
+	st.path = append(st.path, segment{name: name, index: -1})
+}
+
+// pushIndex appends a slice index to the path of the value being validated.
+func (st *state) pushIndex(i int) {
+	st.path = append(st.path, segment{index: i})
+}
+
+// pop removes the last segment of the path of the value being validated.
+func (st *state) pop() {
+	st.path = st.path[:len(st.path)-1]
+}
+
+// fieldPath returns the path of the value being validated joined with field.
+// Paths are only built when reporting errors, so valid values do not allocate.
+func (st *state) fieldPath(field string) string {
+	var sb strings.Builder
+	sb.WriteString(st.prefix)
+	for _, seg := range st.path {
+		name := seg.name
+		if seg.index >= 0 {
+			name = strconv.Itoa(seg.index)
+		}
+		writeSegment(&sb, name)
+	}
+	writeSegment(&sb, field)
+	return sb.String()
+}
+
+// maskPath returns the path of the field matched against the field mask of the validation,
+// made of field names only, without the prefix and slice indexes.
+func (st *state) maskPath(name string) string {
+	var sb strings.Builder
+	for _, seg := range st.path {
+		if seg.index < 0 {
+			writeSegment(&sb, seg.name)
+		}
+	}
+	writeSegment(&sb, name)
+	return sb.String()
+}
+
+// writeSegment appends a segment to the path being built, as joinField does.
+func writeSegment(sb *strings.Builder, name string) {
+	if name == "" {
+		return
+	}
+	if sb.Len() > 0 {
+		sb.WriteByte('.')
+	}
+	sb.WriteString(name)
+}
+
+// finish appends a "canceled" error if the context of the validation was canceled,
+// or a "truncated" diagnostic if errors or values were skipped once the maximum number of errors was reached.
+func (st *state) finish(errs Errors) Errors {
+	if err := st.canceled(); err != nil {
+		return append(errs, &Error{
+			Code:   "canceled",
+			Params: map[string]any{"error": err.Error()},
+			Fatal:  true,
+			Cause:  err,
+		})
+	}
+	if st.skipped && st.done() {
+		errs = append(errs, truncatedError("", st.maxErrors))
+	}
+	return errs
+}
+
+// canceled returns the error of the context of the validation, if any.
+func (st *state) canceled() error {
+	if st.ctx == nil {
+		return nil
+	}
+	return st.ctx.Err()
+}
+
+// report records the error, passes it to the iterator of the validation, if any,
+// and reports whether the validation must stop.
+func (st *state) report(err *Error) bool {
+	if err.blocking() {
+		st.errors++
+	}
+	if st.yield != nil && !st.stopped && !st.yield(err) {
+		st.stopped = true
+	}
+	return st.done()
+}
+
+// reportAll reports the errors until the validation must stop
+// and returns the errors reported.
+func (st *state) reportAll(errs Errors) Errors {
+	for i, err := range errs {
+		if st.report(err) {
+			st.skip(i+1 < len(errs))
+			return errs[:i+1]
+		}
+	}
+	return errs
+}
+
+// done reports whether the maximum number of errors was reached,
+// the context of the validation was canceled or the iteration was stopped.
+func (st *state) done() bool {
+	return st.limit > 0 && st.errors >= st.limit || st.stopped || st.canceled() != nil
+}
+
+// halted reports whether the validation is done before validating more values,
+// recording that they are skipped.
+func (st *state) halted() bool {
+	if st.done() {
+		st.skipped = true
+		return true
+	}
+	return false
+}
+
+// skip records that the remaining work is skipped, if any, when the validation is done.
+func (st *state) skip(remaining bool) {
+	if remaining && st.done() {
+		st.skipped = true
+	}
+}
+
+// errorLimit is the error limit of a validation saved by limitErrors.
+type errorLimit struct {
+	limit   int
+	skipped bool
+}
+
+// limitErrors limits the validation to n more errors and returns the previous limit.
+func (st *state) limitErrors(n int) errorLimit {
+	prev := errorLimit{limit: st.limit, skipped: st.skipped}
+	if limit := st.errors + n; prev.limit == 0 || limit < prev.limit {
+		st.limit = limit
+	}
+	st.skipped = false
+	return prev
+}
+
+// restoreLimit restores the previous limit and reports whether the replaced limit truncated
+// the validation, that is it was reached and errors or values were skipped.
+func (st *state) restoreLimit(prev errorLimit) bool {
+	truncated := st.limit != prev.limit && st.errors >= st.limit && st.skipped
+	st.limit = prev.limit
+	st.skipped = prev.skipped || st.skipped && !truncated
+	return truncated
+}
+
+// fork creates a copy of the state for a concurrent validation,
+// limited to the errors remaining in the state.
+func (st *state) fork() *state {
+	child := *st
+	child.visiting = maps.Clone(st.visiting)
+	child.path = slices.Clone(st.path)
+	child.yield = nil
+	child.errors = 0
+	child.skipped = false
+	if st.limit > 0 {
+		child.limit = st.limit - st.errors
+	}
+	return &child
+}
+
+// concurrent reports whether n items are validated concurrently with eachParallel or runParallel.
+func (st *state) concurrent(n int) bool {
+	return st.pool != nil && n > 1
+}
+
+// eachParallel validates the items with fn concurrently while workers are available,
+// each with its own copy of the state, and reports their errors in order once done.
+// The value is passed to fn with every item, so fn does not need to capture it.
+// A panic of fn stops the remaining items and is raised again on the calling goroutine
+// once every worker is done, as it would be in a sequential validation.
+func eachParallel[V, E any](st *state, value V, items []E, fn func(st *state, value V, item E, i int) Errors) Errors {
+	n := len(items)
+	results := make([]Errors, n)
+	forks := make([]*state, n)
+	runParallel(st, n, func(i int) {
+		forks[i] = st.fork()
+		results[i] = fn(forks[i], value, items[i], i)
+	})
+
+	var out Errors
+	for i, errs := range results {
+		if len(errs) == 0 {
+			continue
+		}
+		if st.halted() {
+			break
+		}
+		out = append(out, st.reportAll(errs)...)
+		st.skipped = st.skipped || forks[i].skipped
+	}
+	return out
+}
+
+// mergeResults joins the errors of the elements of a collection validated concurrently,
+// up to the first fatal error, as the rule validating them sequentially does.
+func mergeResults(results []Errors) Errors {
+	var errs Errors
+	for _, result := range results {
+		if errs = append(errs, result...); stoppedAtFatal(errs) {
+			break
+		}
+	}
+	return errs
+}
+
+// stoppedAtFatal reports whether the last error is fatal, which stops the validation of a collection.
+func stoppedAtFatal(errs Errors) bool {
+	return len(errs) > 0 && errs[len(errs)-1].isFatal()
+}
+
+// runParallel calls fn with every index below n concurrently while workers are available.
+// A panic of fn stops the remaining indexes and is raised again on the calling goroutine
+// once every worker is done.
+func runParallel(st *state, n int, fn func(i int)) {
+	var (
+		next      atomic.Int64
+		panicOnce sync.Once
+		panicked  atomic.Bool
+		recovered any
+	)
+	work := func() {
+		defer func() {
+			if r := recover(); r != nil {
+				panicOnce.Do(func() { recovered = r })
+				panicked.Store(true)
+			}
+		}()
+		for {
+			i := int(next.Add(1) - 1)
+			if i >= n || panicked.Load() || st.canceled() != nil {
+				return
+			}
+			fn(i)
+		}
+	}
+
+	var wg sync.WaitGroup
+spawn:
+	for range n - 1 {
+		select {
+		case st.pool <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-st.pool
+					wg.Done()
+				}()
+				work()
+			}()
+		default:
+			break spawn
+		}
+	}
+	work()
+	wg.Wait()
+	if panicked.Load() {
+		panic(recovered)
+	}
+}
+
+// stateValidator is implemented by validators that share state with nested validators.
+type stateValidator[T any] interface {
+	validate(st *state, value T) Errors
+}
+
+// validateNested validates the value sharing the state when the validator supports it,
+// otherwise it reports the errors of the validator to the state.
+func validateNested[T any](st *state, v fieldValidator[T], value T) (errs Errors) {
+	if sv, ok := v.(stateValidator[T]); ok {
+		return sv.validate(st, value)
+	}
+	if st.panics != 0 {
+		defer st.recoverField(&errs, "", false)
+	}
+	return st.reportAll(v.ValidateWithPrefix(value, st.fieldPath("")))
+}
+
+// enter marks the pointer as being validated.
+// It reports false if the pointer is already being validated higher up the path.
+func (st *state) enter(ptr any) bool {
+	if _, ok := st.visiting[ptr]; ok {
+		return false
+	}
+	if st.visiting == nil {
+		st.visiting = make(map[any]struct{})
+	}
+	st.visiting[ptr] = struct{}{}
+	return true
+}
+
+// leave removes the pointer from the validation path.
+func (st *state) leave(ptr any) {
+	delete(st.visiting, ptr)
+}
@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StateMachine defines the states of an entity and the allowed transitions between them.
+// Transitions can be guarded by rules on the entity.
+type StateMachine[T any, S comparable] struct {
+	states      []S
+	known       map[S]struct{}
+	transitions []transition[T, S]
+}
+
+// transition is an allowed transition between two states.
+type transition[T any, S comparable] struct {
+	from, to S
+	guards   []Rule[T]
+}
+
+// NewStateMachine creates a new StateMachine with the given states.
+func NewStateMachine[T any, S comparable](states ...S) *StateMachine[T, S] {
+	known := make(map[S]struct{}, len(states))
+	for _, s := range states {
+		known[s] = struct{}{}
+	}
+	return &StateMachine[T, S]{states: states, known: known}
+}
+
+// Transition allows the transition from one state to another if all guards pass.
+// It returns the state machine to allow chaining.
+func (m *StateMachine[T, S]) Transition(from, to S, guards ...Rule[T]) *StateMachine[T, S] {
+	m.transitions = append(m.transitions, transition[T, S]{from: from, to: to, guards: guards})
+	return m
+}
+
+// ValidateTransition validates the transition of the entity from one state to another.
+// Unknown states report an "unknown_state" error, transitions that are not allowed an
+// "invalid_transition" error, and failing guards their own errors.
+// Staying in the same state is allowed unless it is guarded by a transition.
+func (m *StateMachine[T, S]) ValidateTransition(entity T, from, to S) Errors {
+	return m.validateTransition(entity, from, to, "", "")
+}
+
+// validateTransition reports transition errors at the state path
+// and guard errors relative to the entity prefix.
+func (m *StateMachine[T, S]) validateTransition(entity T, from, to S, prefix, statePath string) Errors {
+	for _, s := range []S{from, to} {
+		if _, ok := m.known[s]; !ok {
+			return SingleErrorSlice(statePath, "unknown_state", map[string]any{"state": s}, false)
+		}
+	}
+
+	t, ok := m.find(from, to)
+	if !ok {
+		if from == to {
+			return nil
+		}
+		return SingleErrorSlice(statePath, "invalid_transition", map[string]any{"from": from, "to": to}, false)
+	}
+
+	var out Errors
+	for _, guard := range t.guards {
+		if err := guard(entity); err != nil {
+			err.Field = joinField(prefix, err.Field)
+			out = append(out, err)
+			if err.isFatal() {
+				return out
+			}
+		}
+	}
+	return out
+}
+
+// find returns the transition between the states.
+func (m *StateMachine[T, S]) find(from, to S) (transition[T, S], bool) {
+	for _, t := range m.transitions {
+		if t.from == from && t.to == to {
+			return t, true
+		}
+	}
+	return transition[T, S]{}, false
+}
+
+// DOT returns the state machine as a graph in the Graphviz DOT language.
+// Guarded transitions are drawn with dashed edges.
+func (m *StateMachine[T, S]) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph {\n")
+	for _, s := range m.states {
+		fmt.Fprintf(&sb, "\t%s;\n", dotID(s))
+	}
+	for _, t := range m.transitions {
+		fmt.Fprintf(&sb, "\t%s -> %s", dotID(t.from), dotID(t.to))
+		if len(t.guards) > 0 {
+			sb.WriteString(" [style=dashed]")
+		}
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dotID returns the state as a quoted DOT identifier.
+func dotID(state any) string {
+	return strconv.Quote(fmt.Sprintf("%v", state))
+}
+
+// Field creates a StateField validating changes of the state of a struct with the state machine.
+func (m *StateMachine[T, S]) Field(name string, getter func(T) S) StateField[T, S] {
+	return StateField[T, S]{name: name, get: getter, machine: m}
+}
+
+// StateField is a field of a struct holding a state, validated on change.
+// It can be used as a field of a ChangeValidator.
+type StateField[T any, S comparable] struct {
+	name    string
+	get     func(T) S
+	machine *StateMachine[T, S]
+}
+
+// ValidateWithPrefix validates the transition of the state from old to new with a prefix.
+// Transition errors are reported at the field, guard errors at the fields they set.
+func (f StateField[T, S]) ValidateWithPrefix(old, new T, prefix string) Errors {
+	return f.machine.validateTransition(new, f.get(old), f.get(new), prefix, joinField(prefix, f.name))
+}
@@ -0,0 +1,111 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+type order struct {
+	Status string
+	Paid   bool
+}
+
+func TestStateMachineValidateTransition(t *testing.T) {
+	machine := validation.NewStateMachine[order]("pending", "paid", "shipped", "cancelled").
+		Transition("pending", "paid", func(o order) *validation.Error {
+			if !o.Paid {
+				return &validation.Error{Code: "not_paid", Field: "Paid"}
+			}
+			return nil
+		}).
+		Transition("paid", "shipped").
+		Transition("pending", "cancelled")
+
+	tests := []struct {
+		name     string
+		entity   order
+		from, to string
+		want     []string
+		params   map[string]any
+	}{
+		{name: "allowed transition", from: "paid", to: "shipped"},
+		{name: "guarded transition passes", entity: order{Paid: true}, from: "pending", to: "paid"},
+		{name: "same state", from: "shipped", to: "shipped"},
+		{name: "guard fails", from: "pending", to: "paid", want: []string{"not_paid:Paid"}},
+		{
+			name:   "transition not allowed",
+			from:   "shipped",
+			to:     "pending",
+			want:   []string{"invalid_transition:"},
+			params: map[string]any{"from": "shipped", "to": "pending"},
+		},
+		{name: "unknown state", from: "pending", to: "lost", want: []string{"unknown_state:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := machine.ValidateTransition(tt.entity, tt.from, tt.to)
+			if got := errorSummary(errs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			if tt.params != nil && !reflect.DeepEqual(errs[0].Params, tt.params) {
+				t.Errorf("expected params %v, got %v", tt.params, errs[0].Params)
+			}
+		})
+	}
+}
+
+func TestStateMachineField(t *testing.T) {
+	validator := validation.Change(
+		validation.NewStateMachine[order]("pending", "paid", "shipped").
+			Transition("pending", "paid", func(o order) *validation.Error {
+				if !o.Paid {
+					return &validation.Error{Code: "not_paid", Field: "Paid"}
+				}
+				return nil
+			}).
+			Transition("paid", "shipped").
+			Field("Status", func(o order) string { return o.Status }),
+	)
+
+	tests := []struct {
+		name     string
+		old, new order
+		want     []string
+	}{
+		{name: "allowed", old: order{Status: "paid"}, new: order{Status: "shipped"}},
+		{name: "not allowed", old: order{Status: "shipped"}, new: order{Status: "paid"}, want: []string{"invalid_transition:order.Status"}},
+		{name: "guard fails", old: order{Status: "pending"}, new: order{Status: "paid"}, want: []string{"not_paid:order.Paid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorSummary(validator.ValidateWithPrefix(tt.old, tt.new, "order")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStateMachineDOT(t *testing.T) {
+	machine := validation.NewStateMachine[order]("pending", "paid", "shipped", "cancelled").
+		Transition("pending", "paid", validation.NotZero[order]()).
+		Transition("paid", "shipped").
+		Transition("pending", "cancelled")
+
+	want := `digraph {
+	"pending";
+	"paid";
+	"shipped";
+	"cancelled";
+	"pending" -> "paid" [style=dashed];
+	"paid" -> "shipped";
+	"pending" -> "cancelled";
+}
+`
+	if got := machine.DOT(); got != want {
+		t.Errorf("unexpected DOT output:\n%s", got)
+	}
+}
@@ -8,7 +8,14 @@ import (
 
 // StringsRuneLengthBetween validates string length (in runes, not bytes) between the given minimum and maximum.
 func StringsRuneLengthBetween[T ~string](min, max int) Rule[T] {
+	return describe(StringsRuneLengthBetweenFunc[T](func() (int, int) { return min, max }), "StringsRuneLengthBetween")
+}
+
+// StringsRuneLengthBetweenFunc validates string length (in runes) between the bounds returned by fn.
+// The bounds are resolved every time the rule is evaluated.
+func StringsRuneLengthBetweenFunc[T ~string](fn func() (min, max int)) Rule[T] {
 	return func(value T) *Error {
+		min, max := fn()
 		length := utf8.RuneCountInString(string(value))
 		if length < min || length > max {
 			return &Error{
@@ -22,9 +29,15 @@ func StringsRuneLengthBetween[T ~string](min, max int) Rule[T] {
 
 // StringsRuneMinLength validates minimum string length in runes.
 func StringsRuneMinLength[T ~string](min int) Rule[T] {
+	return describe(StringsRuneMinLengthFunc[T](func() int { return min }), "StringsRuneMinLength")
+}
+
+// StringsRuneMinLengthFunc validates minimum string length in runes against the value returned by fn.
+// The minimum is resolved every time the rule is evaluated.
+func StringsRuneMinLengthFunc[T ~string](fn func() int) Rule[T] {
 	return func(value T) *Error {
 		length := utf8.RuneCountInString(string(value))
-		if length < min {
+		if min := fn(); length < min {
 			return &Error{
 				Code:   "min",
 				Params: map[string]any{"min": min, "actual": length},
@@ -36,9 +49,15 @@ func StringsRuneMinLength[T ~string](min int) Rule[T] {
 
 // StringsRuneMaxLength validates maximum string length in runes.
 func StringsRuneMaxLength[T ~string](max int) Rule[T] {
+	return describe(StringsRuneMaxLengthFunc[T](func() int { return max }), "StringsRuneMaxLength")
+}
+
+// StringsRuneMaxLengthFunc validates maximum string length in runes against the value returned by fn.
+// The maximum is resolved every time the rule is evaluated.
+func StringsRuneMaxLengthFunc[T ~string](fn func() int) Rule[T] {
 	return func(value T) *Error {
 		length := utf8.RuneCountInString(string(value))
-		if length > max {
+		if max := fn(); length > max {
 			return &Error{
 				Code:   "max",
 				Params: map[string]any{"max": max, "actual": length},
@@ -50,14 +69,86 @@ func StringsRuneMaxLength[T ~string](max int) Rule[T] {
 
 // StringsMatchesRegex validates string against a regex pattern
 func StringsMatchesRegex[T ~string](pattern string) Rule[T] {
-	regex := regexp.MustCompile(pattern)
+	return describe(StringsMatchesRegexp[T](regexp.MustCompile(pattern)), "StringsMatchesRegex")
+}
+
+// StringsMatchesRegexp validates string against a compiled regular expression.
+func StringsMatchesRegexp[T ~string](re *regexp.Regexp) Rule[T] {
+	pattern := re.String()
 	return func(value T) *Error {
-		if !regex.MatchString(string(value)) {
+		if !re.MatchString(string(value)) {
+			return &Error{
+				Code:   "regex",
+				Params: map[string]any{"pattern": pattern},
+			}
+		}
+		return nil
+	}
+}
+
+// CaptureRule applies rules to a named capture group of a regular expression.
+type CaptureRule struct {
+	name  string
+	rules []Rule[string]
+}
+
+// Capture creates a CaptureRule for the named capture group.
+func Capture(name string, rules ...Rule[string]) CaptureRule {
+	return CaptureRule{name: name, rules: rules}
+}
+
+// StringsRegexpCaptures validates that the string matches the compiled regular expression
+// and applies the rules of each capture to its named group.
+// Errors from capture rules have the group name as field.
+// It panics if a capture references a group that does not exist in the expression.
+func StringsRegexpCaptures[T ~string](re *regexp.Regexp, captures ...CaptureRule) Rule[T] {
+	return describe(Must(StringsRegexpCapturesE[T](re, captures...)), "StringsRegexpCaptures")
+}
+
+// StringsRegexpCapturesE is like StringsRegexpCaptures but returns an error
+// if a capture references a group that does not exist in the expression.
+func StringsRegexpCapturesE[T ~string](re *regexp.Regexp, captures ...CaptureRule) (Rule[T], error) {
+	indexes := make([]int, len(captures))
+	for i, c := range captures {
+		indexes[i] = re.SubexpIndex(c.name)
+		if indexes[i] < 0 {
+			return nil, invalidRule("StringsRegexpCaptures", "unknown capture group %q in %q", c.name, re.String())
+		}
+	}
+
+	pattern := re.String()
+	rule := func(value T) *Error {
+		match := re.FindStringSubmatch(string(value))
+		if match == nil {
 			return &Error{
 				Code:   "regex",
 				Params: map[string]any{"pattern": pattern},
 			}
 		}
+		for i, c := range captures {
+			if err := applyRules(match[indexes[i]], c.rules); err != nil {
+				err.nest(c.name)
+				err.reject(match[indexes[i]])
+				return err
+			}
+		}
+		return nil
+	}
+	return rule, nil
+}
+
+// StringsParse parses the string and applies the rules to the parsed value.
+// A parse failure produces a "parse" error with the error of parse as its cause.
+func StringsParse[S ~string, T any](parse func(string) (T, error), rules ...Rule[T]) Rule[S] {
+	return func(value S) *Error {
+		parsed, err := parse(string(value))
+		if err != nil {
+			return &Error{Code: "parse", Cause: err}
+		}
+		if err := applyRules(parsed, rules); err != nil {
+			err.reject(parsed)
+			return err
+		}
 		return nil
 	}
 }
@@ -74,3 +165,37 @@ func StringsContains[T ~string](substring T) Rule[T] {
 		return nil
 	}
 }
+
+// StringsRuneLengthBetweenE is like StringsRuneLengthBetween but returns an error
+// if a bound is negative or min is greater than max.
+func StringsRuneLengthBetweenE[T ~string](min, max int) (Rule[T], error) {
+	if err := checkLengthRange("StringsRuneLengthBetween", min, max); err != nil {
+		return nil, err
+	}
+	return StringsRuneLengthBetween[T](min, max), nil
+}
+
+// StringsRuneMinLengthE is like StringsRuneMinLength but returns an error if the minimum is negative.
+func StringsRuneMinLengthE[T ~string](min int) (Rule[T], error) {
+	if err := checkLength("StringsRuneMinLength", "min", min); err != nil {
+		return nil, err
+	}
+	return StringsRuneMinLength[T](min), nil
+}
+
+// StringsRuneMaxLengthE is like StringsRuneMaxLength but returns an error if the maximum is negative.
+func StringsRuneMaxLengthE[T ~string](max int) (Rule[T], error) {
+	if err := checkLength("StringsRuneMaxLength", "max", max); err != nil {
+		return nil, err
+	}
+	return StringsRuneMaxLength[T](max), nil
+}
+
+// StringsMatchesRegexE is like StringsMatchesRegex but returns an error if the pattern does not compile.
+func StringsMatchesRegexE[T ~string](pattern string) (Rule[T], error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, invalidRule("StringsMatchesRegex", "%v", err)
+	}
+	return StringsMatchesRegexp[T](re), nil
+}
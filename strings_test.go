@@ -1,6 +1,9 @@
 package validation_test
 
 import (
+	"errors"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/jacoelho/validation"
@@ -403,3 +406,113 @@ func TestStringsErrorParams(t *testing.T) {
 		}
 	})
 }
+
+func TestStringsFunc(t *testing.T) {
+	limit := 3
+	minRule := validation.StringsRuneMinLengthFunc[string](func() int { return limit })
+	maxRule := validation.StringsRuneMaxLengthFunc[string](func() int { return limit })
+	betweenRule := validation.StringsRuneLengthBetweenFunc[string](func() (int, int) { return 1, limit })
+
+	if err := maxRule("hello"); err == nil || err.Code != "max" {
+		t.Fatalf("expected max error, got %v", err)
+	}
+	if err := betweenRule("hello"); err == nil || err.Code != "between" {
+		t.Fatalf("expected between error, got %v", err)
+	}
+
+	limit = 6
+	if err := maxRule("hello"); err != nil {
+		t.Errorf("expected no error after raising limit, got %v", err)
+	}
+	if err := betweenRule("hello"); err != nil {
+		t.Errorf("expected no error after raising limit, got %v", err)
+	}
+	if err := minRule("hello"); err == nil || err.Params["min"] != 6 {
+		t.Errorf("expected min error with min 6, got %v", err)
+	}
+}
+
+func TestStringsMatchesRegexp(t *testing.T) {
+	rule := validation.StringsMatchesRegexp[string](regexp.MustCompile(`^\d+$`))
+
+	if err := rule("123"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	err := rule("abc")
+	if err == nil || err.Code != "regex" {
+		t.Fatalf("expected regex error, got %v", err)
+	}
+	if err.Params["pattern"] != `^\d+$` {
+		t.Errorf("expected pattern param, got %v", err.Params["pattern"])
+	}
+}
+
+func TestStringsRegexpCaptures(t *testing.T) {
+	re := regexp.MustCompile(`^(?P<year>\d{4})-(?P<month>\d{2})$`)
+	rule := validation.StringsRegexpCaptures[string](re,
+		validation.Capture("year", validation.StringsParse[string](strconv.Atoi, validation.NumbersBetween(1900, 2100))),
+		validation.Capture("month", validation.StringsParse[string](strconv.Atoi, validation.NumbersBetween(1, 12))),
+	)
+
+	tests := []struct {
+		name      string
+		value     string
+		wantCode  string
+		wantField string
+	}{
+		{name: "valid", value: "2024-06"},
+		{name: "no match", value: "June 2024", wantCode: "regex"},
+		{name: "invalid year", value: "1800-06", wantCode: "between", wantField: "year"},
+		{name: "invalid month", value: "2024-13", wantCode: "between", wantField: "month"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule(tt.value)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if err.Code != tt.wantCode || err.Field != tt.wantField {
+				t.Errorf("expected %s (field %q), got %s (field %q)", tt.wantCode, tt.wantField, err.Code, err.Field)
+			}
+		})
+	}
+
+	t.Run("field path", func(t *testing.T) {
+		type Period struct{ Value string }
+		v := validation.Struct(
+			validation.Field("Value", func(p Period) string { return p.Value }, rule),
+		)
+		errs := v.Validate(Period{Value: "2024-00"})
+		if len(errs) != 1 || errs[0].Field != "Value.month" {
+			t.Errorf("expected error on Value.month, got %v", errs)
+		}
+	})
+
+	t.Run("unknown group", func(t *testing.T) {
+		_, err := validation.StringsRegexpCapturesE[string](re, validation.Capture("day"))
+		if !errors.Is(err, validation.ErrInvalidRule) {
+			t.Errorf("expected ErrInvalidRule, got %v", err)
+		}
+	})
+}
+
+func TestStringsParse(t *testing.T) {
+	rule := validation.StringsParse[string](strconv.Atoi, validation.NumbersPositive[int]())
+
+	if err := rule("42"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := rule("-1"); err == nil || err.Code != "positive" {
+		t.Errorf("expected positive error, got %v", err)
+	}
+	if err := rule("abc"); err == nil || err.Code != "parse" || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+}
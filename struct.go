@@ -1,5 +1,10 @@
 package validation
 
+import (
+	"slices"
+	"time"
+)
+
 // fieldValidator is a validator for a field of a struct.
 type fieldValidator[T any] interface {
 	ValidateWithPrefix(T, string) Errors
@@ -7,7 +12,10 @@ type fieldValidator[T any] interface {
 
 // StructValidator is a validator for a struct.
 type StructValidator[T any] struct {
-	fields []fieldValidator[T]
+	fields   []fieldValidator[T]
+	lazy     func() *StructValidator[T]
+	maxDepth int
+	name     string
 }
 
 // Struct creates a new StructValidator with the given fields.
@@ -15,26 +23,263 @@ func Struct[T any](fields ...fieldValidator[T]) *StructValidator[T] {
 	return &StructValidator[T]{fields: fields}
 }
 
+// Value creates a StructValidator that applies the rules to the value itself.
+// It is meant for element validation with SliceStructField and MapStructField,
+// which stop validating elements once the context of the WithContext option is canceled.
+func Value[T any](rules ...Rule[T]) *StructValidator[T] {
+	return Struct(Field("", func(value T) T { return value }, rules...))
+}
+
+// Lazy creates a StructValidator that resolves the validator returned by fn
+// at validation time, allowing recursive and mutually recursive validators.
+// MaxDepth and Named apply to the resolved validator.
+// A nil validator returned by fn reports a fatal "nil_validator" error.
+func Lazy[T any](fn func() *StructValidator[T]) *StructValidator[T] {
+	return &StructValidator[T]{lazy: fn}
+}
+
+// MaxDepth sets the maximum nesting depth of struct validators when this validator
+// is the root of the validation, replacing DefaultMaxDepth.
+// Exceeding the depth reports a fatal "max_depth" error instead of validating further.
+// It returns the validator to allow chaining.
+func (v *StructValidator[T]) MaxDepth(depth int) *StructValidator[T] {
+	v.maxDepth = depth
+	return v
+}
+
+// Named sets the name of the validator reported to observers, see WithObserver.
+// It returns the validator to allow chaining.
+func (v *StructValidator[T]) Named(name string) *StructValidator[T] {
+	v.name = name
+	return v
+}
+
+// Extend creates a new StructValidator with the fields of v followed by the given fields.
+// The fields of a Lazy validator are resolved at validation time.
+func (v *StructValidator[T]) Extend(fields ...fieldValidator[T]) *StructValidator[T] {
+	return &StructValidator[T]{
+		fields:   append(slices.Clip(v.ownFields()), fields...),
+		maxDepth: v.maxDepth,
+		name:     v.name,
+	}
+}
+
+// Merge creates a new StructValidator with the fields of v followed by the fields of others.
+// The fields of Lazy validators are resolved at validation time.
+func (v *StructValidator[T]) Merge(others ...*StructValidator[T]) *StructValidator[T] {
+	fields := slices.Clone(v.ownFields())
+	for _, other := range others {
+		fields = append(fields, other.ownFields()...)
+	}
+	return &StructValidator[T]{fields: fields, maxDepth: v.maxDepth, name: v.name}
+}
+
+// Pick creates a new StructValidator with only the fields with the given names.
+// Fields without a name, such as embedded validators, are not picked.
+func (v *StructValidator[T]) Pick(names ...string) *StructValidator[T] {
+	return v.filter(func(name string, ok bool) bool {
+		return ok && slices.Contains(names, name)
+	})
+}
+
+// Omit creates a new StructValidator without the fields with the given names.
+func (v *StructValidator[T]) Omit(names ...string) *StructValidator[T] {
+	return v.filter(func(name string, ok bool) bool {
+		return !ok || !slices.Contains(names, name)
+	})
+}
+
+// filter creates a new StructValidator with the fields for which keep returns true.
+// The fields of a Lazy validator are filtered once resolved at validation time.
+func (v *StructValidator[T]) filter(keep func(name string, ok bool) bool) *StructValidator[T] {
+	var fields []fieldValidator[T]
+	for _, field := range v.ownFields() {
+		if lazy, ok := field.(lazyFields[T]); ok {
+			fields = append(fields, lazy.filter(keep))
+			continue
+		}
+		name, ok := fieldName(field)
+		if keep(name, ok) {
+			fields = append(fields, field)
+		}
+	}
+	return &StructValidator[T]{fields: fields, maxDepth: v.maxDepth, name: v.name}
+}
+
+// ownFields returns the fields of the validator, or a single field resolving
+// the fields of a Lazy validator at validation time.
+func (v *StructValidator[T]) ownFields() []fieldValidator[T] {
+	if v.lazy != nil {
+		return []fieldValidator[T]{lazyFields[T]{lazy: v.lazy}}
+	}
+	return v.fields
+}
+
+// lazyFields validates the fields of the validator returned by a lazy function,
+// keeping the fields of a Lazy validator combined with Extend, Merge, Pick and Omit.
+type lazyFields[T any] struct {
+	lazy func() *StructValidator[T]
+	keep []func(name string, ok bool) bool
+}
+
+// filter returns a copy of the lazy fields that also skips the fields for which keep returns false.
+func (l lazyFields[T]) filter(keep func(name string, ok bool) bool) lazyFields[T] {
+	l.keep = append(slices.Clip(l.keep), keep)
+	return l
+}
+
+// ValidateWithPrefix validates the given value with a prefix.
+func (l lazyFields[T]) ValidateWithPrefix(value T, prefix string) Errors {
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return l.validate(st, value)
+}
+
+func (l lazyFields[T]) validate(st *state, value T) Errors {
+	resolved := l.lazy()
+	if resolved == nil {
+		return nilValidator(st, value)
+	}
+
+	var out Errors
+	for _, field := range resolved.ownFields() {
+		if st.halted() {
+			break
+		}
+		if lazy, ok := field.(lazyFields[T]); ok {
+			field = lazyFields[T]{lazy: lazy.lazy, keep: append(slices.Clip(lazy.keep), l.keep...)}
+		} else if !l.keeps(field) {
+			continue
+		}
+		out = append(out, validateNested(st, field, value)...)
+	}
+	return out
+}
+
+// keeps reports whether the field is kept by every filter.
+func (l lazyFields[T]) keeps(field fieldValidator[T]) bool {
+	name, ok := fieldName(field)
+	for _, keep := range l.keep {
+		if !keep(name, ok) {
+			return false
+		}
+	}
+	return true
+}
+
 // Validate validates the given value.
 func (v *StructValidator[T]) Validate(value T) Errors {
 	return v.ValidateWithPrefix(value, "")
 }
 
+// Check validates the given value and returns the errors as an error,
+// or nil if there are no errors. Warnings and informational diagnostics alone return nil.
+func (v *StructValidator[T]) Check(value T) error {
+	return v.Validate(value).Err()
+}
+
 // ValidateWithPrefix validates the given value with a prefix.
 func (v *StructValidator[T]) ValidateWithPrefix(value T, prefix string) Errors {
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return v.validate(st, value)
+}
+
+func (v *StructValidator[T]) validate(st *state, value T) Errors {
+	if v.lazy != nil {
+		return v.validateLazy(st, value)
+	}
+
+	if v.maxDepth > 0 && st.depth == 0 {
+		st.maxDepth = v.maxDepth
+	}
+	if st.depth >= st.maxDepth {
+		errs := SingleErrorSlice(st.fieldPath(""), "max_depth", map[string]any{"max": st.maxDepth}, true)
+		st.capture(errs[0], value)
+		st.report(errs[0])
+		return errs
+	}
+	st.depth++
+	defer func() { st.depth-- }()
+
+	if v.name != "" {
+		parent := st.validator
+		st.validator = v.name
+		defer func() { st.validator = parent }()
+	}
+
+	if st.concurrent(len(v.fields)) {
+		return eachParallel(st, value, v.fields, func(st *state, value T, field fieldValidator[T], _ int) Errors {
+			return validateNested(st, field, value)
+		})
+	}
+
 	var out Errors
 	for _, field := range v.fields {
-		out = append(out, field.ValidateWithPrefix(value, prefix)...)
+		if st.halted() {
+			break
+		}
+		out = append(out, validateNested(st, field, value)...)
 	}
 	return out
 }
 
+// validateLazy validates the value with the validator returned by the lazy function.
+// MaxDepth and Named set on the lazy validator take precedence over the resolved validator.
+// A nil validator reports a fatal "nil_validator" error.
+func (v *StructValidator[T]) validateLazy(st *state, value T) Errors {
+	resolved := v.lazy()
+	if resolved == nil {
+		return nilValidator(st, value)
+	}
+	if v.maxDepth == 0 && v.name == "" {
+		return resolved.validate(st, value)
+	}
+	configured := *resolved
+	if v.maxDepth > 0 {
+		configured.maxDepth = v.maxDepth
+	}
+	if v.name != "" {
+		configured.name = v.name
+	}
+	return configured.validate(st, value)
+}
+
+// nilValidator reports the fatal "nil_validator" error of a lazy function returning nil.
+func nilValidator[T any](st *state, value T) Errors {
+	errs := SingleErrorSlice(st.fieldPath(""), "nil_validator", nil, true)
+	st.capture(errs[0], value)
+	st.report(errs[0])
+	return errs
+}
+
+// hasFields reports whether the validator has fields, which is always the case.
+func (v *StructValidator[T]) hasFields() bool {
+	return true
+}
+
+// validateFields validates the fields of the validator.
+func (v *StructValidator[T]) validateFields(st *state, value T) Errors {
+	return v.validate(st, value)
+}
+
 // FieldAccessor is a field of a struct.
 type FieldAccessor[T, F any] struct {
-	name  string
-	get   func(T) F
-	rules []Rule[F]
-	inner fieldValidator[F]
+	name       string
+	get        func(T) F
+	rules      []Rule[F]
+	inner      fieldValidator[F]
+	omitEmpty  bool
+	groups     []string
+	groupRules []groupRule[F]
+	refs       []string
+	maxErrors  int
+	when       func(T) bool
+	unless     func(T) bool
+	sensitive  bool
+	keepFields bool
 }
 
 // Field creates a new FieldAccessor with the given name, getter and rules.
@@ -51,6 +296,15 @@ func StructField[T, F any](name string, getter func(T) F, validator *StructValid
 	}
 }
 
+// Embed creates a new FieldAccessor that validates an embedded struct with the validator
+// without adding a path segment, so its errors are reported as fields of the parent.
+func Embed[T, E any](getter func(T) E, validator *StructValidator[E]) FieldAccessor[T, E] {
+	return FieldAccessor[T, E]{
+		get:   getter,
+		inner: validator,
+	}
+}
+
 // SliceField creates a new FieldAccessor with the given name, getter and rules.
 func SliceField[T, E any](name string, getter func(T) []E, rules ...SliceRule[E]) FieldAccessor[T, []E] {
 	return FieldAccessor[T, []E]{
@@ -60,6 +314,16 @@ func SliceField[T, E any](name string, getter func(T) []E, rules ...SliceRule[E]
 	}
 }
 
+// SliceStructField creates a new FieldAccessor with the given name, getter and rules
+// that also validates each element of the slice with the validator.
+func SliceStructField[T, E any](name string, getter func(T) []E, validator *StructValidator[E], rules ...SliceRule[E]) FieldAccessor[T, []E] {
+	return FieldAccessor[T, []E]{
+		name:  name,
+		get:   getter,
+		inner: &SliceValidator[E]{rules: rules, each: validator},
+	}
+}
+
 // MapField creates a new FieldAccessor with the given name, getter and rules.
 func MapField[T any, K comparable, V any](name string, getter func(T) map[K]V, rules ...MapRule[K, V]) FieldAccessor[T, map[K]V] {
 	return FieldAccessor[T, map[K]V]{
@@ -69,36 +333,219 @@ func MapField[T any, K comparable, V any](name string, getter func(T) map[K]V, r
 	}
 }
 
+// MapStructField creates a new FieldAccessor with the given name, getter and rules
+// that also validates each value of the map with the validator.
+// Values are validated in the order of their formatted keys.
+func MapStructField[T any, K comparable, V any](name string, getter func(T) map[K]V, validator *StructValidator[V], rules ...MapRule[K, V]) FieldAccessor[T, map[K]V] {
+	return FieldAccessor[T, map[K]V]{
+		name:  name,
+		get:   getter,
+		inner: &MapValidator[K, V]{rules: rules, each: validator},
+	}
+}
+
+// OmitEmpty returns a copy of the field that skips validation when the value is empty.
+// A value is empty when it is a nil pointer or interface, when its IsZero method reports true,
+// when it is a string, slice or map of length zero, or otherwise when it is the zero value of its type.
+func (fa FieldAccessor[T, F]) OmitEmpty() FieldAccessor[T, F] {
+	fa.omitEmpty = true
+	return fa
+}
+
+// When returns a copy of the field that is only validated if the condition on the parent is true.
+func (fa FieldAccessor[T, F]) When(condition func(parent T) bool) FieldAccessor[T, F] {
+	fa.when = condition
+	return fa
+}
+
+// Unless returns a copy of the field that is only validated if the condition on the parent is false.
+func (fa FieldAccessor[T, F]) Unless(condition func(parent T) bool) FieldAccessor[T, F] {
+	fa.unless = condition
+	return fa
+}
+
+// Sensitive returns a copy of the field whose errors are marked as sensitive,
+// including the errors of its nested fields, so their params are redacted.
+// Traces record the inputs of its rules as Redacted.
+func (fa FieldAccessor[T, F]) Sensitive() FieldAccessor[T, F] {
+	fa.sensitive = true
+	return fa
+}
+
+// MaxErrors returns a copy of the field that stops validating the field once n errors
+// have been reported for it, appending a "truncated" diagnostic at the field
+// when values of the field are left unvalidated.
+func (fa FieldAccessor[T, F]) MaxErrors(n int) FieldAccessor[T, F] {
+	fa.maxErrors = n
+	return fa
+}
+
 // ValidateWithPrefix validates the given value with a prefix.
 func (fa FieldAccessor[T, F]) ValidateWithPrefix(parent T, prefix string) Errors {
-	var out Errors
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return fa.validate(st, parent)
+}
+
+func (fa FieldAccessor[T, F]) validate(st *state, parent T) (errs Errors) {
+	active := st.inGroups(fa.groups)
+	passThrough := !active && fa.passesThrough()
+	if !active && !passThrough && len(fa.groupRules) == 0 {
+		return nil
+	}
+
+	included, masked := true, false
+	if st.mask != nil {
+		included, masked = fa.masked(st, st.maskPath(fa.name))
+		if !included && !masked {
+			return nil
+		}
+	}
+
+	if st.panics != 0 {
+		defer st.recoverField(&errs, fa.name, fa.sensitive)
+	}
+	if fa.when != nil && !fa.when(parent) {
+		st.traceSkip(fa.name, "When")
+		return nil
+	}
+	if fa.unless != nil && fa.unless(parent) {
+		st.traceSkip(fa.name, "Unless")
+		return nil
+	}
+
 	value := fa.get(parent)
+	if fa.omitEmpty && isEmpty(value) {
+		st.traceSkip(fa.name, "OmitEmpty")
+		return nil
+	}
+
+	st.push(fa.name)
+	defer st.pop()
+	st.traceEnter(fa.name)
+	defer st.traceLeave()
+	if fa.sensitive && !st.sensitive {
+		st.sensitive = true
+		defer func() { st.sensitive = false }()
+	}
 
-	fieldPath := fa.name
-	if prefix != "" {
-		fieldPath = prefix + "." + fa.name
+	if st.observer == nil {
+		return fa.validateLimited(st, value, active, passThrough, included)
 	}
+	event := FieldEvent{Validator: st.validator, Field: st.fieldPath("")}
+	st.observer.FieldStart(event)
+	start := time.Now()
+	out := fa.validateLimited(st, value, active, passThrough, included)
+	event.Duration = time.Since(start)
+	event.Errors = len(out)
+	st.observer.FieldEnd(event)
+	return out
+}
 
-	if fa.inner != nil {
-		for _, err := range fa.inner.ValidateWithPrefix(value, "") {
-			err.Field = joinField(fieldPath, err.Field)
+// validateLimited validates the value of the field within the error limit of the field, if any.
+func (fa FieldAccessor[T, F]) validateLimited(st *state, value F, active, passThrough, included bool) Errors {
+	if fa.maxErrors > 0 {
+		prev := st.limitErrors(fa.maxErrors)
+		out := fa.validateValue(st, value, active, passThrough, included)
+		if st.restoreLimit(prev) {
+			err := truncatedError(st.fieldPath(""), fa.maxErrors)
+			st.capture(err, value)
+			st.report(err)
 			out = append(out, err)
 		}
 		return out
 	}
+	return fa.validateValue(st, value, active, passThrough, included)
+}
 
-	for _, rule := range fa.rules {
-		if err := rule(value); err != nil {
-			err.Field = fieldPath
+// validateValue applies the rules of the field, its group rules and its inner validator to the value.
+// When the field passes through to its nested fields, only the nested fields are validated.
+func (fa FieldAccessor[T, F]) validateValue(st *state, value F, active, passThrough, included bool) Errors {
+	var out Errors
+
+	if active && included {
+		for i, rule := range fa.rules {
+			if err := evaluate(st, rule, value, fa.keepFields); err != nil {
+				out = append(out, err)
+				if st.stop(err) {
+					traceSkipRules(st, fa.rules[i+1:], err)
+					st.skip(i+1 < len(fa.rules) || len(fa.groupRules) > 0 || fa.inner != nil)
+					return out
+				}
+			}
+		}
+	}
+
+	for i, gr := range fa.groupRules {
+		if !included || !st.inGroup(gr.group) {
+			continue
+		}
+		if err := evaluate(st, gr.rule, value, fa.keepFields); err != nil {
 			out = append(out, err)
-			if err.Fatal {
-				break
+			if st.stop(err) {
+				st.skip(i+1 < len(fa.groupRules) || fa.inner != nil)
+				return out
 			}
 		}
 	}
+
+	if active && fa.inner != nil {
+		out = append(out, fa.validateInner(st, value)...)
+	} else if passThrough {
+		out = append(out, fa.inner.(fieldsValidator[F]).validateFields(st, value)...)
+	}
 	return out
 }
 
+// validateInner applies the inner validator of the field to the value.
+// Validators that do not share the state are validated without a prefix,
+// and their errors are joined with the field path.
+func (fa FieldAccessor[T, F]) validateInner(st *state, value F) Errors {
+	if sv, ok := fa.inner.(stateValidator[F]); ok {
+		return sv.validate(st, value)
+	}
+	errs := fa.inner.ValidateWithPrefix(value, "")
+	for _, err := range errs {
+		err.Field = st.fieldPath(err.Field)
+		err.Sensitive = err.Sensitive || st.sensitive
+	}
+	return st.reportAll(errs)
+}
+
+// masked reports whether the field is included by the mask of the validation,
+// or whether it is the parent of included fields.
+// Struct rules are included when they reference an included field or no fields.
+func (fa FieldAccessor[T, F]) masked(st *state, path string) (included, parent bool) {
+	if fa.name != "" || fa.inner != nil {
+		return st.mask.match(path)
+	}
+	if len(fa.refs) == 0 {
+		return true, false
+	}
+	for _, ref := range fa.refs {
+		if included, parent := st.mask.match(joinField(path, ref)); included || parent {
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// fieldName returns the name of the field, if it has one.
+func fieldName[T any](field fieldValidator[T]) (string, bool) {
+	named, ok := field.(interface{ fieldName() string })
+	if !ok {
+		return "", false
+	}
+	name := named.fieldName()
+	return name, name != ""
+}
+
+// fieldName returns the name of the field.
+func (fa FieldAccessor[T, F]) fieldName() string {
+	return fa.name
+}
+
 // joinField joins two field paths.
 func joinField(base, child string) string {
 	if base == "" {
@@ -1,6 +1,8 @@
 package validation_test
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/jacoelho/validation"
@@ -441,3 +443,276 @@ func TestStructValidationWithFatalErrors(t *testing.T) {
 		})
 	}
 }
+
+type category struct {
+	Name     string
+	Children []category
+}
+
+type node struct {
+	Name string
+	Next *node
+}
+
+func TestLazyRecursiveValidation(t *testing.T) {
+	var categoryValidator *validation.StructValidator[category]
+	categoryValidator = validation.Struct(
+		validation.Field("Name", func(c category) string { return c.Name },
+			validation.NotZero[string](),
+		),
+		validation.SliceStructField("Children", func(c category) []category { return c.Children },
+			validation.Lazy(func() *validation.StructValidator[category] { return categoryValidator }),
+			validation.SlicesMaxLength[category](2),
+		),
+	)
+
+	tree := category{
+		Name: "root",
+		Children: []category{
+			{Name: "a"},
+			{Name: "b", Children: []category{{Name: ""}}},
+		},
+	}
+
+	errs := categoryValidator.Validate(tree)
+	if len(errs) != 1 || errs[0].Field != "Children.1.Children.0.Name" || errs[0].Code != "zero" {
+		t.Errorf("expected zero error on Children.1.Children.0.Name, got %v", errs)
+	}
+}
+
+func TestRecursiveMaxDepth(t *testing.T) {
+	var categoryValidator *validation.StructValidator[category]
+	categoryValidator = validation.Struct(
+		validation.SliceStructField("Children", func(c category) []category { return c.Children },
+			validation.Lazy(func() *validation.StructValidator[category] { return categoryValidator }),
+		),
+	).MaxDepth(2)
+
+	deep := category{Children: []category{{Children: []category{{}}}}}
+
+	errs := categoryValidator.Validate(deep)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if errs[0].Code != "max_depth" || errs[0].Field != "Children.0.Children.0" || !errs[0].Fatal {
+		t.Errorf("expected fatal max_depth error on Children.0.Children.0, got %v", errs[0])
+	}
+
+	if errs := categoryValidator.Validate(category{Children: []category{{}}}); len(errs) != 0 {
+		t.Errorf("expected no errors within max depth, got %v", errs)
+	}
+}
+
+func TestLazyOptions(t *testing.T) {
+	var categoryValidator *validation.StructValidator[category]
+	categoryValidator = validation.Struct(
+		validation.SliceStructField("Children", func(c category) []category { return c.Children },
+			validation.Lazy(func() *validation.StructValidator[category] { return categoryValidator }),
+		),
+	)
+	deep := category{Children: []category{{Children: []category{{}}}}}
+
+	tests := []struct {
+		name      string
+		validator *validation.StructValidator[category]
+		want      []string
+	}{
+		{
+			name:      "max depth",
+			validator: validation.Lazy(func() *validation.StructValidator[category] { return categoryValidator }).MaxDepth(1),
+			want:      []string{"max_depth:Children.0"},
+		},
+		{
+			name:      "nil validator",
+			validator: validation.Lazy(func() *validation.StructValidator[category] { return nil }),
+			want:      []string{"nil_validator:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorSummary(tt.validator.Validate(deep)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	observer := &recordingObserver{}
+	named := validation.Lazy(func() *validation.StructValidator[category] { return categoryValidator }).Named("category")
+	named.ValidateWithOptions(category{}, validation.WithObserver(observer))
+	if want := []string{"start category Children", "end category Children 0"}; !reflect.DeepEqual(observer.events, want) {
+		t.Errorf("expected %v, got %v", want, observer.events)
+	}
+}
+
+func TestRecursivePointerCycle(t *testing.T) {
+	var nodeValidator *validation.StructValidator[node]
+	nodeValidator = validation.Struct(
+		validation.Field("Name", func(n node) string { return n.Name },
+			validation.NotZero[string](),
+		),
+		validation.PtrStructField("Next", func(n node) *node { return n.Next },
+			validation.Lazy(func() *validation.StructValidator[node] { return nodeValidator }),
+		),
+	)
+
+	a := &node{Name: "a"}
+	b := &node{Name: ""}
+	a.Next = b
+	b.Next = a
+
+	// a -> b -> a -> (b already being validated, skipped)
+	errs := nodeValidator.Validate(*a)
+	if len(errs) != 1 || errs[0].Field != "Next.Name" {
+		t.Errorf("expected a single error on Next.Name, got %v", errs)
+	}
+}
+
+func TestMapStructField(t *testing.T) {
+	type Directory struct {
+		Offices map[string]Address
+	}
+
+	validator := validation.Struct(
+		validation.MapStructField("Offices", func(d Directory) map[string]Address { return d.Offices },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+			validation.MapsMinKeys[string, Address](1),
+		),
+	)
+
+	errs := validator.Validate(Directory{Offices: map[string]Address{
+		"porto":  {},
+		"lisbon": {},
+		"faro":   {City: "Faro"},
+	}})
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if errs[0].Field != "Offices.lisbon.City" || errs[1].Field != "Offices.porto.City" {
+		t.Errorf("expected errors ordered by key, got %v", errs)
+	}
+}
+
+func TestStructValidatorComposition(t *testing.T) {
+	type CreateUser struct {
+		Name  string
+		Email string
+		Age   int
+	}
+
+	name := validation.Field("Name", func(u CreateUser) string { return u.Name }, validation.NotZero[string]())
+	email := validation.Field("Email", func(u CreateUser) string { return u.Email }, validation.NotZero[string]())
+	age := validation.Field("Age", func(u CreateUser) int { return u.Age }, validation.NumbersMin(18))
+
+	base := validation.Struct(name, email)
+	lazy := validation.Lazy(func() *validation.StructValidator[CreateUser] { return base })
+	invalid := CreateUser{}
+
+	fields := func(errs validation.Errors) []string {
+		var out []string
+		for _, err := range errs {
+			out = append(out, err.Field)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name      string
+		validator *validation.StructValidator[CreateUser]
+		want      []string
+	}{
+		{name: "extend", validator: base.Extend(age), want: []string{"Name", "Email", "Age"}},
+		{name: "merge", validator: base.Merge(validation.Struct(age)), want: []string{"Name", "Email", "Age"}},
+		{name: "pick", validator: base.Extend(age).Pick("Age", "Name"), want: []string{"Name", "Age"}},
+		{name: "omit", validator: base.Extend(age).Omit("Email"), want: []string{"Name", "Age"}},
+		{name: "base unchanged", validator: base, want: []string{"Name", "Email"}},
+		{name: "extend lazy", validator: lazy.Extend(age), want: []string{"Name", "Email", "Age"}},
+		{name: "merge lazy", validator: validation.Struct(age).Merge(lazy), want: []string{"Age", "Name", "Email"}},
+		{name: "pick lazy", validator: lazy.Extend(age).Pick("Age", "Name"), want: []string{"Name", "Age"}},
+		{name: "omit lazy", validator: lazy.Merge(lazy.Omit("Name")).Omit("Email"), want: []string{"Name"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields(tt.validator.Validate(invalid))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected fields %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleErrorFields(t *testing.T) {
+	type Form struct {
+		Name   string
+		Tags   []string
+		Period string
+	}
+
+	custom := func(value string) *validation.Error {
+		if value == "" {
+			return &validation.Error{Field: "custom", Code: "zero"}
+		}
+		return nil
+	}
+	period := validation.StringsRegexpCaptures[string](regexp.MustCompile(`^(?P<year>\d{4})$`),
+		validation.Capture("year", validation.StringsRuneMaxLength[string](2)),
+	)
+
+	validator := validation.Struct(
+		validation.Field("Name", func(f Form) string { return f.Name }, custom),
+		validation.SliceField("Tags", func(f Form) []string { return f.Tags },
+			validation.SlicesForEach(custom, period),
+		),
+		validation.Field("Period", func(f Form) string { return f.Period }, period),
+	)
+
+	errs := validator.Validate(Form{Tags: []string{"", "2024"}, Period: "2024"})
+	want := []string{"Name", "Tags.0", "Tags.0", "Tags.1.year", "Period.year"}
+	got := make([]string, len(errs))
+	for i, err := range errs {
+		got[i] = err.Field
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected fields %v, got %v", want, got)
+	}
+}
+
+func TestEmbed(t *testing.T) {
+	type Timestamps struct {
+		CreatedBy string
+	}
+	type Post struct {
+		Timestamps
+		Title string
+	}
+
+	timestamps := validation.Struct(
+		validation.Field("CreatedBy", func(t Timestamps) string { return t.CreatedBy },
+			validation.NotZero[string](),
+		),
+	)
+
+	validator := validation.Struct(
+		validation.Embed(func(p Post) Timestamps { return p.Timestamps }, timestamps),
+		validation.Field("Title", func(p Post) string { return p.Title },
+			validation.NotZero[string](),
+		),
+	)
+
+	errs := validator.ValidateWithPrefix(Post{}, "post")
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if errs[0].Field != "post.CreatedBy" || errs[1].Field != "post.Title" {
+		t.Errorf("expected embedded fields without a path segment, got %v", errs)
+	}
+
+	if errs := validator.Omit("Title").Validate(Post{}); len(errs) != 1 || errs[0].Field != "CreatedBy" {
+		t.Errorf("expected embedded validator to be kept by Omit, got %v", errs)
+	}
+}
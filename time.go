@@ -4,8 +4,14 @@ import "time"
 
 // TimeBeforeOrEqual validates that the time is before the given time.
 func TimeBeforeOrEqual(other time.Time) Rule[time.Time] {
+	return describe(TimeBeforeOrEqualFunc(func() time.Time { return other }), "TimeBeforeOrEqual")
+}
+
+// TimeBeforeOrEqualFunc validates that the time is before or equal to the time returned by fn.
+// The bound is resolved every time the rule is evaluated, e.g. time.Now.
+func TimeBeforeOrEqualFunc(fn func() time.Time) Rule[time.Time] {
 	return func(value time.Time) *Error {
-		if value.After(other) {
+		if other := fn(); value.After(other) {
 			return &Error{Code: "before", Params: map[string]any{"value": other}}
 		}
 		return nil
@@ -14,8 +20,14 @@ func TimeBeforeOrEqual(other time.Time) Rule[time.Time] {
 
 // TimeBefore validates that the time is before the given time.
 func TimeBefore(other time.Time) Rule[time.Time] {
+	return describe(TimeBeforeFunc(func() time.Time { return other }), "TimeBefore")
+}
+
+// TimeBeforeFunc validates that the time is before the time returned by fn.
+// The bound is resolved every time the rule is evaluated, e.g. time.Now.
+func TimeBeforeFunc(fn func() time.Time) Rule[time.Time] {
 	return func(value time.Time) *Error {
-		if !value.Before(other) {
+		if other := fn(); !value.Before(other) {
 			return &Error{Code: "before", Params: map[string]any{"value": other}}
 		}
 		return nil
@@ -24,8 +36,14 @@ func TimeBefore(other time.Time) Rule[time.Time] {
 
 // TimeAfterOrEqual validates that the time is after the given time.
 func TimeAfterOrEqual(other time.Time) Rule[time.Time] {
+	return describe(TimeAfterOrEqualFunc(func() time.Time { return other }), "TimeAfterOrEqual")
+}
+
+// TimeAfterOrEqualFunc validates that the time is after or equal to the time returned by fn.
+// The bound is resolved every time the rule is evaluated, e.g. time.Now.
+func TimeAfterOrEqualFunc(fn func() time.Time) Rule[time.Time] {
 	return func(value time.Time) *Error {
-		if value.Before(other) {
+		if other := fn(); value.Before(other) {
 			return &Error{Code: "after", Params: map[string]any{"value": other}}
 		}
 		return nil
@@ -34,8 +52,14 @@ func TimeAfterOrEqual(other time.Time) Rule[time.Time] {
 
 // TimeAfter validates that the time is after the given time.
 func TimeAfter(other time.Time) Rule[time.Time] {
+	return describe(TimeAfterFunc(func() time.Time { return other }), "TimeAfter")
+}
+
+// TimeAfterFunc validates that the time is after the time returned by fn.
+// The bound is resolved every time the rule is evaluated, e.g. time.Now.
+func TimeAfterFunc(fn func() time.Time) Rule[time.Time] {
 	return func(value time.Time) *Error {
-		if !value.After(other) {
+		if other := fn(); !value.After(other) {
 			return &Error{Code: "after", Params: map[string]any{"value": other}}
 		}
 		return nil
@@ -44,10 +68,25 @@ func TimeAfter(other time.Time) Rule[time.Time] {
 
 // TimeBetween validates that the time is between the given times.
 func TimeBetween(min, max time.Time) Rule[time.Time] {
+	return describe(TimeBetweenFunc(func() (time.Time, time.Time) { return min, max }), "TimeBetween")
+}
+
+// TimeBetweenFunc validates that the time is between the bounds returned by fn.
+// The bounds are resolved every time the rule is evaluated.
+func TimeBetweenFunc(fn func() (min, max time.Time)) Rule[time.Time] {
 	return func(value time.Time) *Error {
+		min, max := fn()
 		if value.Before(min) || value.After(max) {
 			return &Error{Code: "between", Params: map[string]any{"min": min, "max": max, "value": value}}
 		}
 		return nil
 	}
 }
+
+// TimeBetweenE is like TimeBetween but returns an error if min is after max.
+func TimeBetweenE(min, max time.Time) (Rule[time.Time], error) {
+	if min.After(max) {
+		return nil, invalidRule("TimeBetween", "min %v is after max %v", min, max)
+	}
+	return TimeBetween(min, max), nil
+}
@@ -360,3 +360,36 @@ func TestTimeEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestTimeFunc(t *testing.T) {
+	now := date(2024, 1, 1)
+	clock := func() time.Time { return now }
+
+	before := validation.TimeBeforeFunc(clock)
+	after := validation.TimeAfterFunc(clock)
+	value := date(2024, 6, 1)
+
+	if err := before(value); err == nil || err.Code != "before" {
+		t.Fatalf("expected before error, got %v", err)
+	}
+	if err := after(value); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	now = date(2025, 1, 1)
+	if err := before(value); err != nil {
+		t.Errorf("expected no error after moving clock, got %v", err)
+	}
+	if err := after(value); err == nil || !err.Params["value"].(time.Time).Equal(now) {
+		t.Errorf("expected after error with current bound, got %v", err)
+	}
+	if err := validation.TimeBeforeOrEqualFunc(clock)(now); err != nil {
+		t.Errorf("expected no error for equal time, got %v", err)
+	}
+	if err := validation.TimeAfterOrEqualFunc(clock)(now); err != nil {
+		t.Errorf("expected no error for equal time, got %v", err)
+	}
+	if err := validation.TimeBetweenFunc(func() (time.Time, time.Time) { return date(2024, 1, 1), now })(value); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+	"weak"
+)
+
+// Trace records the fields and rules of a validation, see WithTrace.
+// A Trace records a single validation at a time.
+type Trace struct {
+	// RedactInputs records the inputs of rules as Redacted.
+	// Inputs of sensitive fields are always redacted.
+	RedactInputs bool
+
+	root  traceNode
+	stack []*traceNode
+}
+
+// traceNode is a field or a rule recorded by a trace.
+type traceNode struct {
+	name     string
+	rule     bool
+	input    string
+	err      *Error
+	skipped  string
+	children []*traceNode
+}
+
+// WithTrace records the fields validated and the rules evaluated by the validation in the trace,
+// with the input and result of every rule, and the fields and rules skipped.
+// Tracing validates sequentially, ignoring Parallel.
+func WithTrace(trace *Trace) Option {
+	return func(st *state) {
+		trace.root = traceNode{}
+		trace.stack = []*traceNode{&trace.root}
+		st.trace = trace
+	}
+}
+
+// String renders the trace as an indented tree of fields and rules.
+func (t *Trace) String() string {
+	var sb strings.Builder
+	for _, child := range t.root.children {
+		child.write(&sb, 0)
+	}
+	return sb.String()
+}
+
+// write renders the node and its children at the given depth.
+func (n *traceNode) write(sb *strings.Builder, depth int) {
+	sb.WriteString(strings.Repeat("  ", depth))
+	sb.WriteString(n.name)
+	if n.rule && n.skipped == "" {
+		sb.WriteString("(")
+		sb.WriteString(n.input)
+		sb.WriteString(")")
+	}
+	switch {
+	case n.skipped != "":
+		sb.WriteString(": skipped by ")
+		sb.WriteString(n.skipped)
+	case n.err != nil:
+		result := *n.err.Redacted(RedactSensitive)
+		result.Field = ""
+		sb.WriteString(": ")
+		sb.WriteString(result.Level().String())
+		sb.WriteString(" ")
+		sb.WriteString(result.Error())
+	case n.rule:
+		sb.WriteString(": ok")
+	}
+	sb.WriteString("\n")
+	for _, child := range n.children {
+		child.write(sb, depth+1)
+	}
+}
+
+// current returns the node of the field being validated.
+func (t *Trace) current() *traceNode {
+	return t.stack[len(t.stack)-1]
+}
+
+// add appends the node to the node of the field being validated.
+func (t *Trace) add(node *traceNode) {
+	current := t.current()
+	current.children = append(current.children, node)
+}
+
+// enter records a field and makes it the field being validated.
+// Fields without a name, such as embedded structs, record their children in the parent.
+func (t *Trace) enter(name string) {
+	if name == "" {
+		t.stack = append(t.stack, t.current())
+		return
+	}
+	node := &traceNode{name: name}
+	t.add(node)
+	t.stack = append(t.stack, node)
+}
+
+// leave ends the field being validated.
+func (t *Trace) leave() {
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+// rule records the evaluation of the named rule with its input and error.
+// Inputs of sensitive values are redacted.
+func (t *Trace) rule(name string, input any, err *Error, sensitive bool) {
+	node := &traceNode{name: name, rule: true, err: err, input: Redacted}
+	if !t.RedactInputs && !sensitive {
+		node.input = formatInput(input)
+	}
+	t.add(node)
+}
+
+// traceEnter records the field, if the validation is traced.
+func (st *state) traceEnter(name string) {
+	if st.trace != nil {
+		st.trace.enter(name)
+	}
+}
+
+// traceLeave ends the field, if the validation is traced.
+func (st *state) traceLeave() {
+	if st.trace != nil {
+		st.trace.leave()
+	}
+}
+
+// traceSkip records a field skipped for the given reason, if the validation is traced.
+func (st *state) traceSkip(name, reason string) {
+	if st.trace != nil && name != "" {
+		st.trace.add(&traceNode{name: name, skipped: reason})
+	}
+}
+
+// traceSkipRules records the rules left when the validation of a value stops because of err.
+func traceSkipRules[F any](st *state, rules []Rule[F], err *Error) {
+	if st.trace == nil {
+		return
+	}
+	reason := "error limit"
+	if err.isFatal() {
+		reason = "fatal error"
+	}
+	for _, rule := range rules {
+		st.trace.add(&traceNode{name: ruleName(rule), rule: true, skipped: reason})
+	}
+}
+
+// ruleInfo describes a rule created by the package, see describe.
+type ruleInfo struct {
+	closure weak.Pointer[byte]
+	name    string
+	// condition is the condition[T] of a rule created by When or Unless, see traceCondition.
+	condition any
+	// each validates an element of a rule created by SlicesForEach or MapsForEach,
+	// see parallelSliceRule and parallelMapRule.
+	each any
+}
+
+// condition is the condition of a rule created by When or Unless,
+// and the rule applied when the condition holds.
+type condition[T any] struct {
+	holds  func(T) bool
+	unless bool
+	rule   Rule[T]
+}
+
+// ruleInfos holds the descriptions of rules, keyed by the address of their closure.
+var ruleInfos sync.Map // map[uintptr]*ruleInfo
+
+// describe names the rule in traces, e.g. a rule delegating to another constructor,
+// and returns the rule. The rule must be a closure capturing variables, so that it is
+// allocated for this rule only. The description is dropped when the rule is collected.
+func describe[R any](rule R, name string) R {
+	describeInfo(rule, &ruleInfo{name: name})
+	return rule
+}
+
+// describeInfo records the description of the rule, like describe.
+func describeInfo[R any](rule R, info *ruleInfo) {
+	closure := ruleClosure(rule)
+	info.closure = weak.Make(closure)
+	key := uintptr(unsafe.Pointer(closure))
+	ruleInfos.Store(key, info)
+	runtime.AddCleanup(closure, func(key uintptr) { ruleInfos.CompareAndDelete(key, info) }, key)
+}
+
+// describedRule returns the description of the rule, if any.
+func describedRule[R any](rule R) *ruleInfo {
+	closure := ruleClosure(rule)
+	v, ok := ruleInfos.Load(uintptr(unsafe.Pointer(closure)))
+	if !ok {
+		return nil
+	}
+	// The closure may have been collected, and its address reused, before the description is dropped.
+	if info := v.(*ruleInfo); info.closure.Value() == closure {
+		return info
+	}
+	return nil
+}
+
+// ruleClosure returns the closure of a rule, R being a function type.
+func ruleClosure[R any](rule R) *byte {
+	return *(**byte)(unsafe.Pointer(&rule))
+}
+
+// traceCondition returns the condition of a rule created by When or Unless, if the validation is traced.
+func traceCondition[F any](st *state, rule Rule[F]) (condition[F], bool) {
+	if st.trace == nil {
+		return condition[F]{}, false
+	}
+	if info := describedRule(rule); info != nil {
+		c, ok := info.condition.(condition[F])
+		return c, ok
+	}
+	return condition[F]{}, false
+}
+
+// ruleName returns the name of the rule given by describe, or otherwise the name
+// of the function that created the rule, e.g. a custom rule.
+func ruleName[R any](rule R) string {
+	if info := describedRule(rule); info != nil {
+		return info.name
+	}
+	fn := runtime.FuncForPC(reflect.ValueOf(rule).Pointer())
+	if fn == nil {
+		return "rule"
+	}
+	name := fn.Name()
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+	name = name[strings.LastIndexByte(name, '/')+1:]
+	parts := strings.Split(name, ".")
+	if len(parts) > 1 && !strings.HasPrefix(parts[1], "func") {
+		return parts[1]
+	}
+	return name
+}
+
+// formatInput formats the input of a rule, quoting strings.
+func formatInput(input any) string {
+	if rv := reflect.ValueOf(input); rv.Kind() == reflect.String {
+		return strconv.Quote(rv.String())
+	}
+	return fmt.Sprintf("%v", input)
+}
@@ -0,0 +1,192 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+func TestTrace(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name },
+			validation.NotZero[string](),
+			validation.StringsRuneMaxLength[string](50),
+		),
+		validation.Field("Age", func(u User) int { return u.Age },
+			validation.RuleStopOnError(validation.NumbersMin(18)),
+			validation.NumbersMax(120),
+		),
+		validation.Field("Email", func(u User) string { return u.Email },
+			validation.NotZero[string](),
+		).Unless(func(u User) bool { return u.Age < 18 }),
+		validation.StructField("Address", func(u User) Address { return u.Address },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		).When(func(u User) bool { return u.Name != "" }),
+		validation.SliceStructField("Tags", func(u User) []string { return u.Tags },
+			validation.Value(validation.WarnOnly(validation.StringsRuneMinLength[string](2))),
+			validation.SlicesMaxLength[string](5),
+		).OmitEmpty(),
+		validation.MapField("Settings", func(u User) map[string]string { return u.Settings }),
+	)
+
+	tests := []struct {
+		name  string
+		trace *validation.Trace
+		value User
+		want  string
+	}{
+		{
+			name:  "rules and skipped fields",
+			trace: &validation.Trace{},
+			value: User{Age: 10},
+			want: `Name
+  NotZero(""): error zero
+  StringsRuneMaxLength(""): ok
+Age
+  RuleStopOnError(10): fatal min {actual: 10, min: 18}
+  NumbersMax: skipped by fatal error
+Email: skipped by Unless
+Address: skipped by When
+Tags: skipped by OmitEmpty
+Settings
+`,
+		},
+		{
+			name:  "nested fields and elements",
+			trace: &validation.Trace{},
+			value: User{Name: "John", Age: 30, Email: "a@b.c", Tags: []string{"a", "bc"}},
+			want: `Name
+  NotZero("John"): ok
+  StringsRuneMaxLength("John"): ok
+Age
+  RuleStopOnError(30): ok
+  NumbersMax(30): ok
+Email
+  NotZero("a@b.c"): ok
+Address
+  City
+    NotZero(""): error zero
+Tags
+  SlicesMaxLength([a bc]): ok
+  0
+    WarnOnly("a"): warning min {actual: 1, min: 2}
+  1
+    WarnOnly("bc"): ok
+Settings
+`,
+		},
+		{
+			name:  "redacted inputs",
+			trace: &validation.Trace{RedactInputs: true},
+			value: User{Name: "John", Age: 30, Email: "a@b.c"},
+			want: `Name
+  NotZero([REDACTED]): ok
+  StringsRuneMaxLength([REDACTED]): ok
+Age
+  RuleStopOnError([REDACTED]): ok
+  NumbersMax([REDACTED]): ok
+Email
+  NotZero([REDACTED]): ok
+Address
+  City
+    NotZero([REDACTED]): error zero
+Tags: skipped by OmitEmpty
+Settings
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator.ValidateWithOptions(tt.value, validation.WithTrace(tt.trace), validation.Parallel(4))
+			if got := tt.trace.String(); got != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFieldWhenUnless(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name },
+			validation.NotZero[string](),
+		),
+		validation.Field("Email", func(u User) string { return u.Email },
+			validation.NotZero[string](),
+		).Unless(func(u User) bool { return u.Age < 18 }),
+		validation.StructField("Address", func(u User) Address { return u.Address },
+			validation.Struct(
+				validation.Field("City", func(a Address) string { return a.City },
+					validation.NotZero[string](),
+				),
+			),
+		).When(func(u User) bool { return u.Name != "" }),
+	)
+
+	tests := []struct {
+		name  string
+		value User
+		want  []string
+	}{
+		{name: "skipped", value: User{Age: 10}, want: []string{"zero:Name"}},
+		{name: "validated", value: User{Name: "John", Age: 30}, want: []string{"zero:Email", "zero:Address.City"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorSummary(validator.Validate(tt.value)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTraceConditionalRules(t *testing.T) {
+	validator := validation.Struct(
+		validation.Field("Name", func(u User) string { return u.Name },
+			validation.When(func(s string) bool { return s != "" }, validation.StringsRuneMinLength[string](3)),
+			validation.Unless(func(s string) bool { return s == "" }, validation.StringsRuneMaxLength[string](5)),
+			validation.StringsRuneMaxLengthFunc[string](func() int { return 10 }),
+		),
+	)
+
+	tests := []struct {
+		name  string
+		value User
+		want  string
+	}{
+		{
+			name:  "condition does not apply",
+			value: User{},
+			want: `Name
+  When: skipped by condition
+  Unless: skipped by condition
+  StringsRuneMaxLengthFunc(""): ok
+`,
+		},
+		{
+			name:  "condition applies",
+			value: User{Name: "Jo"},
+			want: `Name
+  When("Jo"): error min {actual: 2, min: 3}
+  Unless("Jo"): ok
+  StringsRuneMaxLengthFunc("Jo"): ok
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trace := &validation.Trace{}
+			validator.ValidateWithOptions(tt.value, validation.WithTrace(trace))
+			if got := trace.String(); got != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package validation
+
+import "fmt"
+
+// TypeCase validates values of an interface type holding a specific concrete type.
+type TypeCase[I any] struct {
+	validate func(st *state, value I) (Errors, bool)
+}
+
+// Case creates a TypeCase that validates values holding the concrete type C with the validator.
+func Case[I, C any](validator *StructValidator[C]) TypeCase[I] {
+	return TypeCase[I]{
+		validate: func(st *state, value I) (Errors, bool) {
+			concrete, ok := any(value).(C)
+			if !ok {
+				return nil, false
+			}
+			return validator.validate(st, concrete), true
+		},
+	}
+}
+
+// PtrCase creates a TypeCase that validates values holding the concrete type *C
+// with the validator, skipping nil pointers.
+func PtrCase[I, C any](validator *StructValidator[C]) TypeCase[I] {
+	ptr := ptrValidator[C]{validator: validator}
+	return TypeCase[I]{
+		validate: func(st *state, value I) (Errors, bool) {
+			concrete, ok := any(value).(*C)
+			if !ok {
+				return nil, false
+			}
+			return ptr.validate(st, concrete), true
+		},
+	}
+}
+
+// UnionValidator is a validator for interface values that dispatches on the dynamic type.
+type UnionValidator[I any] struct {
+	cases []TypeCase[I]
+}
+
+// Union creates a new UnionValidator with the given cases.
+// The first case matching the dynamic type of the value is applied.
+// A nil value is not validated, and a value not matching any case
+// reports an "unknown_type" error.
+func Union[I any](cases ...TypeCase[I]) *UnionValidator[I] {
+	return &UnionValidator[I]{cases: cases}
+}
+
+// Validate validates the given value.
+func (v *UnionValidator[I]) Validate(value I) Errors {
+	return v.ValidateWithPrefix(value, "")
+}
+
+// ValidateWithPrefix validates the given value with a prefix.
+func (v *UnionValidator[I]) ValidateWithPrefix(value I, prefix string) Errors {
+	st := newState()
+	defer st.release()
+	st.prefix = prefix
+	return v.validate(st, value)
+}
+
+func (v *UnionValidator[I]) validate(st *state, value I) Errors {
+	if any(value) == nil {
+		return nil
+	}
+	if errs, ok := v.validateCase(st, value); ok {
+		return errs
+	}
+	errs := SingleErrorSlice(st.fieldPath(""), "unknown_type", map[string]any{"type": fmt.Sprintf("%T", value)}, false)
+	st.capture(errs[0], value)
+	st.report(errs[0])
+	return errs
+}
+
+// validateCase validates the value with the first case matching its dynamic type,
+// and reports whether a case matched.
+func (v *UnionValidator[I]) validateCase(st *state, value I) (Errors, bool) {
+	for _, c := range v.cases {
+		if errs, ok := c.validate(st, value); ok {
+			return errs, true
+		}
+	}
+	return nil, false
+}
+
+// hasFields reports whether the validator has cases, which validate the fields of the value.
+func (v *UnionValidator[I]) hasFields() bool {
+	return len(v.cases) > 0
+}
+
+// validateFields validates the fields of the value with the case matching its dynamic type, if any.
+// Values not matching any case are only reported by validate.
+func (v *UnionValidator[I]) validateFields(st *state, value I) Errors {
+	if any(value) == nil {
+		return nil
+	}
+	errs, _ := v.validateCase(st, value)
+	return errs
+}
+
+// UnionField creates a new FieldAccessor for an interface typed field
+// validated by the case matching its dynamic type.
+func UnionField[T, I any](name string, getter func(T) I, cases ...TypeCase[I]) FieldAccessor[T, I] {
+	return FieldAccessor[T, I]{
+		name:  name,
+		get:   getter,
+		inner: Union(cases...),
+	}
+}
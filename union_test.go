@@ -0,0 +1,90 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/jacoelho/validation"
+)
+
+type paymentMethod interface {
+	isPaymentMethod()
+}
+
+type card struct {
+	Number string
+}
+
+func (*card) isPaymentMethod() {}
+
+type bankTransfer struct {
+	IBAN string
+}
+
+func (bankTransfer) isPaymentMethod() {}
+
+type cash struct{}
+
+func (cash) isPaymentMethod() {}
+
+func TestUnionField(t *testing.T) {
+	type Checkout struct {
+		Payment paymentMethod
+	}
+
+	validator := validation.Struct(
+		validation.UnionField("Payment", func(c Checkout) paymentMethod { return c.Payment },
+			validation.PtrCase[paymentMethod](validation.Struct(
+				validation.Field("Number", func(c card) string { return c.Number },
+					validation.StringsRuneLengthBetween[string](12, 19),
+				),
+			)),
+			validation.Case[paymentMethod](validation.Struct(
+				validation.Field("IBAN", func(b bankTransfer) string { return b.IBAN },
+					validation.NotZero[string](),
+				),
+			)),
+		),
+	)
+
+	tests := []struct {
+		name     string
+		checkout Checkout
+		errCode  string
+		errField string
+	}{
+		{name: "valid card", checkout: Checkout{Payment: &card{Number: "4111111111111111"}}},
+		{name: "valid bank transfer", checkout: Checkout{Payment: bankTransfer{IBAN: "PT50000201231234567890154"}}},
+		{name: "nil payment is skipped", checkout: Checkout{}},
+		{name: "nil card is skipped", checkout: Checkout{Payment: (*card)(nil)}},
+		{name: "invalid card", checkout: Checkout{Payment: &card{Number: "123"}}, errCode: "between", errField: "Payment.Number"},
+		{name: "invalid bank transfer", checkout: Checkout{Payment: bankTransfer{}}, errCode: "zero", errField: "Payment.IBAN"},
+		{name: "unknown type", checkout: Checkout{Payment: cash{}}, errCode: "unknown_type", errField: "Payment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.Validate(tt.checkout)
+			if tt.errCode == "" {
+				if len(errs) > 0 {
+					t.Errorf("expected no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %v", errs)
+			}
+			if errs[0].Code != tt.errCode || errs[0].Field != tt.errField {
+				t.Errorf("expected %s (field %s), got %v", tt.errCode, tt.errField, errs[0])
+			}
+		})
+	}
+}
+
+func TestUnionUnknownTypeParams(t *testing.T) {
+	validator := validation.Union[paymentMethod]()
+
+	errs := validator.Validate(cash{})
+	if len(errs) != 1 || errs[0].Params["type"] != "validation_test.cash" {
+		t.Errorf("expected unknown_type error with type param, got %v", errs)
+	}
+}